@@ -0,0 +1,324 @@
+package dict
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// ErrDuplicateValue is returned by BiMap.Put when the value is already bound to another key
+var ErrDuplicateValue = errors.New("value already bound to another key")
+
+// BiMap is a dictionary keeping a one-to-one mapping between keys and values, with constant time lookups in both directions
+//
+// note:
+//   - a value is bound to at most one key: Put fails on a duplicate value, ForcePut / Add remove the previous binding
+//   - Inverse returns a live view sharing the same storage, so changes are visible in both directions
+//   - the zero value is an empty BiMap ready to use, and a nil BiMap is an empty one which cannot be modified
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+// builder
+
+func NewBi[K comparable, V comparable]() *BiMap[K, V] {
+	d := &BiMap[K, V]{}
+	d.init()
+	return d
+}
+
+func (d *BiMap[K, V]) With(key K, value V) *BiMap[K, V] {
+	d.ForcePut(key, value)
+	return d
+}
+
+// getter
+
+func (d *BiMap[K, V]) Len() int {
+	return len(d.getForward())
+}
+
+func (d *BiMap[K, V]) Keys() []K {
+	return Dict[K, V](d.getForward()).Keys()
+}
+
+func (d *BiMap[K, V]) Values() []V {
+	return Dict[K, V](d.getForward()).Values()
+}
+
+func (d *BiMap[K, V]) ToDict() Dict[K, V] {
+	return Copy(Dict[K, V](d.getForward()))
+}
+
+// Inverse returns a live view of the BiMap from values to keys
+func (d *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if d == nil {
+		return nil
+	}
+	d.init()
+	return &BiMap[V, K]{forward: d.backward, backward: d.forward}
+}
+
+// state
+
+func (d *BiMap[K, V]) IsEmpty() bool {
+	return len(d.getForward()) == 0
+}
+
+func (d *BiMap[K, V]) AllOf(predicate util.Predicate[V]) bool {
+	return AllOf(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) AllKeyOf(predicate util.BiPredicate[K, V]) bool {
+	return AllKeyOf(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) AnyOf(predicate util.Predicate[V]) bool {
+	return AnyOf(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) AnyKeyOf(predicate util.BiPredicate[K, V]) bool {
+	return AnyKeyOf(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) NoneOf(predicate util.Predicate[V]) bool {
+	return NoneOf(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) NoKeyOf(predicate util.BiPredicate[K, V]) bool {
+	return NoKeyOf(d.getForward(), predicate)
+}
+
+// each
+
+func (d *BiMap[K, V]) Each(consumer util.Consumer[V]) {
+	Each(d.getForward(), consumer)
+}
+
+func (d *BiMap[K, V]) EachKey(consumer util.BiConsumer[K, V]) {
+	EachKey(d.getForward(), consumer)
+}
+
+func (d *BiMap[K, V]) EachWhile(consumer util.Predicate[V]) bool {
+	return EachWhile(d.getForward(), consumer)
+}
+
+func (d *BiMap[K, V]) EachKeyWhile(consumer util.BiPredicate[K, V]) bool {
+	return EachKeyWhile(d.getForward(), consumer)
+}
+
+func (d *BiMap[K, V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return EachErr(d.getForward(), consumer)
+}
+
+func (d *BiMap[K, V]) EachKeyErr(consumer util.BiErrConsumer[K, V]) error {
+	return EachKeyErr(d.getForward(), consumer)
+}
+
+// find
+
+func (d *BiMap[K, V]) FindKey(key K) bool {
+	_, found := d.getForward()[key]
+	return found
+}
+
+func (d *BiMap[K, V]) FindValueFromKey(key K) (V, bool) {
+	value, found := d.getForward()[key]
+	return value, found
+}
+
+func (d *BiMap[K, V]) Find(value V) bool {
+	_, found := d.getBackward()[value]
+	return found
+}
+
+func (d *BiMap[K, V]) FindKeyFromValue(value V) (K, bool) {
+	key, found := d.getBackward()[value]
+	return key, found
+}
+
+func (d *BiMap[K, V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	return FindIf(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) FindIfKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	return FindIfKey(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return FindIfNot(d.getForward(), predicate)
+}
+
+func (d *BiMap[K, V]) FindIfNotKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	return FindIfNotKey(d.getForward(), predicate)
+}
+
+// copy
+
+func (d *BiMap[K, V]) Copy() *BiMap[K, V] {
+	return d.CopyIfKey(util.BiTrue[K, V]())
+}
+
+func (d *BiMap[K, V]) CopyIf(predicate util.Predicate[V]) *BiMap[K, V] {
+	return d.CopyIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *BiMap[K, V]) CopyIfKey(predicate util.BiPredicate[K, V]) *BiMap[K, V] {
+	if d == nil {
+		return nil
+	}
+	copy := NewBi[K, V]()
+	for k, v := range d.forward {
+		if predicate(k, v) {
+			copy.forward[k] = v
+			copy.backward[v] = k
+		}
+	}
+	return copy
+}
+
+func (d *BiMap[K, V]) CopyIfNot(predicate util.Predicate[V]) *BiMap[K, V] {
+	return d.CopyIf(util.Not(predicate))
+}
+
+func (d *BiMap[K, V]) CopyIfNotKey(predicate util.BiPredicate[K, V]) *BiMap[K, V] {
+	return d.CopyIfKey(util.BiNot(predicate))
+}
+
+// compare
+
+func (d *BiMap[K, V]) Equal(other *BiMap[K, V]) bool {
+	if d == nil && other == nil {
+		return true
+	}
+	if d == nil || other == nil {
+		return false
+	}
+	return Equal(d.forward, other.forward)
+}
+
+// modifier
+
+// Add binds the key to the value like ForcePut, and returns true if the key was already bound
+func (d *BiMap[K, V]) Add(key K, value V) bool {
+	if d == nil {
+		return false
+	}
+	_, overriden := d.forward[key]
+	d.ForcePut(key, value)
+	return overriden
+}
+
+// Put binds the key to the value, and fails with ErrDuplicateValue if the value is bound to another key
+func (d *BiMap[K, V]) Put(key K, value V) error {
+	if d == nil {
+		return nil
+	}
+	if other, found := d.backward[value]; found && other != key {
+		return fmt.Errorf("%w: %v is bound to %v", ErrDuplicateValue, value, other)
+	}
+	d.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut binds the key to the value, removing any previous binding of the key or the value,
+// and returns true if the BiMap has been updated
+func (d *BiMap[K, V]) ForcePut(key K, value V) bool {
+	if d == nil {
+		return false
+	}
+	d.init()
+	if old, found := d.forward[key]; found {
+		if old == value {
+			return false
+		}
+		delete(d.backward, old)
+	}
+	if other, found := d.backward[value]; found {
+		delete(d.forward, other)
+	}
+	d.forward[key] = value
+	d.backward[value] = key
+	return true
+}
+
+func (d *BiMap[K, V]) Remove(key K) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	value, found := d.forward[key]
+	if !found {
+		return false
+	}
+	delete(d.forward, key)
+	delete(d.backward, value)
+	return true
+}
+
+func (d *BiMap[K, V]) RemoveValue(value V) bool {
+	return d.Inverse().Remove(value)
+}
+
+func (d *BiMap[K, V]) Clear() bool {
+	if d.Len() == 0 {
+		return false
+	}
+	// note: maps are emptied in place to keep the inverse views in sync
+	for k, v := range d.forward {
+		delete(d.forward, k)
+		delete(d.backward, v)
+	}
+	return true
+}
+
+func (d *BiMap[K, V]) RemoveIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *BiMap[K, V]) RemoveIfKey(predicate util.BiPredicate[K, V]) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	updated := false
+	for k, v := range d.forward {
+		if predicate(k, v) {
+			delete(d.forward, k)
+			delete(d.backward, v)
+			updated = true
+		}
+	}
+	return updated
+}
+
+func (d *BiMap[K, V]) KeepIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIf(util.Not(predicate))
+}
+
+func (d *BiMap[K, V]) KeepIfKey(predicate util.BiPredicate[K, V]) bool {
+	return d.RemoveIfKey(util.BiNot(predicate))
+}
+
+// internal
+
+func (d *BiMap[K, V]) getForward() map[K]V {
+	if d == nil {
+		return nil
+	}
+	return d.forward
+}
+
+func (d *BiMap[K, V]) getBackward() map[V]K {
+	if d == nil {
+		return nil
+	}
+	return d.backward
+}
+
+func (d *BiMap[K, V]) init() {
+	if d.forward == nil {
+		d.forward = map[K]V{}
+		d.backward = map[V]K{}
+	}
+}
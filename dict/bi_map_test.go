@@ -0,0 +1,158 @@
+package dict_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+func TestBiMap(t *testing.T) {
+
+	// builder
+
+	d := dict.NewBi[int, string]().
+		With(10, "a").
+		With(20, "b")
+
+	// put
+
+	require.NoError(t, d.Put(30, "c"), "put 30-c")
+	require.NoError(t, d.Put(30, "c"), "put 30-c twice")
+	require.ErrorIs(t, d.Put(40, "a"), dict.ErrDuplicateValue, "put duplicate value a")
+	require.False(t, d.FindKey(40), "failed put does not add 40")
+
+	// force put
+
+	require.True(t, d.ForcePut(40, "a"), "force put 40-a")
+	require.False(t, d.FindKey(10), "10 unbound by force put")
+	require.False(t, d.ForcePut(40, "a"), "force put 40-a twice")
+	require.True(t, d.Add(40, "d"), "add overrides 40")
+	require.False(t, d.Find("a"), "a unbound by add")
+
+	// map[20:b 30:c 40:d]
+
+	// find in both directions
+
+	value, found := d.FindValueFromKey(30)
+	require.Equal(t, "c", value, "value of 30")
+	require.True(t, found, "found 30")
+	key, found := d.FindKeyFromValue("d")
+	require.Equal(t, 40, key, "key of d")
+	require.True(t, found, "found d")
+	_, found = d.FindKeyFromValue("a")
+	require.False(t, found, "found a")
+
+	// inverse view is live
+
+	inverse := d.Inverse()
+	require.Equal(t, dict.Dict[string, int]{"b": 20, "c": 30, "d": 40}, inverse.ToDict(), "inverse")
+	require.True(t, inverse.ForcePut("e", 50), "put through inverse")
+	value, _ = d.FindValueFromKey(50)
+	require.Equal(t, "e", value, "put through inverse visible")
+	require.True(t, d.Remove(20), "remove 20")
+	require.False(t, inverse.Find(20), "remove visible in inverse")
+	require.True(t, d.RemoveValue("c"), "remove value c")
+	require.Equal(t, dict.Dict[int, string]{40: "d", 50: "e"}, d.ToDict(), "after removes")
+
+	// predicate
+
+	require.True(t, d.AllKeyOf(func(k int, v string) bool { return k >= 40 && v >= "d" }), "all_key_of")
+	require.True(t, d.NoneOf(func(v string) bool { return v == "a" }), "none_of")
+
+	// copy is independent
+
+	copy := d.CopyIfKey(func(k int, _ string) bool { return k == 40 })
+	require.True(t, copy.ForcePut(60, "f"), "put in copy")
+	require.False(t, d.FindKey(60), "copy shares storage")
+	require.True(t, copy.Inverse().Find(60), "copy inverse")
+
+	// equal
+
+	var none *dict.BiMap[int, string]
+	require.True(t, d.Equal(d.Copy()), "equal to copy")
+	require.False(t, d.Equal(copy), "equal to other")
+	require.False(t, d.Equal(none), "equal to nil")
+	require.False(t, none.Equal(d), "nil equal to non-nil")
+	require.True(t, none.Equal(nil), "nil equal to nil")
+
+	// remove if
+
+	require.True(t, d.RemoveIf(func(v string) bool { return v == "e" }), "remove if e")
+	require.False(t, inverse.FindKey("e"), "remove if visible in inverse")
+
+	// clear through the inverse
+
+	require.True(t, inverse.Clear(), "clear")
+	require.True(t, d.IsEmpty(), "clear visible")
+	require.False(t, d.Clear(), "clear twice")
+
+	// zero value
+
+	var zero dict.BiMap[int, int]
+	zeroInverse := zero.Inverse()
+	require.NoError(t, zero.Put(1, 2), "put to zero value")
+	require.True(t, zeroInverse.FindKey(2), "zero value inverse is live")
+}
+
+func TestBiMapNil(t *testing.T) {
+
+	var d *dict.BiMap[int, string]
+
+	// getter
+
+	require.Equal(t, 0, d.Len(), "len")
+	require.Empty(t, d.Keys(), "keys")
+	require.Empty(t, d.Values(), "values")
+	require.Nil(t, d.ToDict(), "to dict")
+	require.Nil(t, d.Inverse(), "inverse")
+	require.True(t, d.IsEmpty(), "is_empty")
+
+	// find
+
+	require.False(t, d.FindKey(10), "find key")
+	require.False(t, d.Find("a"), "find value")
+	_, found := d.FindKeyFromValue("a")
+	require.False(t, found, "find key from value")
+	require.True(t, d.AllOf(func(string) bool { return false }), "all_of")
+	require.Nil(t, d.Copy(), "copy")
+
+	// modifier
+
+	require.False(t, d.Add(10, "a"), "add")
+	require.NoError(t, d.Put(10, "a"), "put")
+	require.False(t, d.ForcePut(10, "a"), "force put")
+	require.False(t, d.Remove(10), "remove")
+	require.False(t, d.RemoveValue("a"), "remove value")
+	require.False(t, d.Clear(), "clear")
+	require.False(t, d.RemoveIf(func(string) bool { return true }), "remove if")
+	require.False(t, d.KeepIf(func(string) bool { return false }), "keep if")
+}
+
+//
+// benchmarks
+//
+
+func BenchmarkFindKeyFromValue(b *testing.B) {
+	for _, size := range []int{10, 1000, 100000} {
+		d := dict.Dict[int, string]{}
+		bi := dict.NewBi[int, string]()
+		for i := 0; i < size; i++ {
+			d[i] = fmt.Sprint(i)
+			bi.ForcePut(i, fmt.Sprint(i))
+		}
+		value := fmt.Sprint(size / 2)
+		b.Run(fmt.Sprintf("dict/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				d.FindKeyFromValue(value)
+			}
+		})
+		b.Run(fmt.Sprintf("bi-map/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bi.FindKeyFromValue(value)
+			}
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package dict
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// ConcurrentDict is a dictionary safe for concurrent use, with one lock per shard of keys
+//
+// note:
+//   - each key is assigned to a shard by its hash, so goroutines working on different shards do not contend
+//   - iterations are weakly consistent: each shard is snapshotted under its read lock,
+//     then the consumer is called without holding any lock, so it can modify the dictionary
+//   - predicates of RemoveIf / KeepIf and functions of Compute* are called under the write lock of the shard,
+//     so they must not access the dictionary
+//   - the zero value is an empty dictionary ready to use, with DefaultShards shards and the default hash
+type ConcurrentDict[K comparable, V comparable] struct {
+	once   sync.Once
+	hash   util.HashFunc[K]
+	shards []*concurrentShard[K, V]
+	mask   uint64
+}
+
+type concurrentShard[K comparable, V comparable] struct {
+	sync.RWMutex
+	items Dict[K, V]
+}
+
+const DefaultShards = 32
+
+// builder
+
+func NewConcurrent[K comparable, V comparable](shards int) *ConcurrentDict[K, V] {
+	return NewConcurrentFn[K, V](shards, nil)
+}
+
+func NewConcurrentFn[K comparable, V comparable](shards int, hash util.HashFunc[K]) *ConcurrentDict[K, V] {
+	d := &ConcurrentDict[K, V]{}
+	d.once.Do(func() { d.setup(shards, hash) })
+	return d
+}
+
+func (d *ConcurrentDict[K, V]) With(key K, value V) *ConcurrentDict[K, V] {
+	d.Add(key, value)
+	return d
+}
+
+// getter
+
+func (d *ConcurrentDict[K, V]) Len() int {
+	size := 0
+	for _, shard := range d.allShards() {
+		shard.RLock()
+		size += len(shard.items)
+		shard.RUnlock()
+	}
+	return size
+}
+
+func (d *ConcurrentDict[K, V]) Keys() []K {
+	l := make([]K, 0, d.Len())
+	d.EachKey(func(k K, _ V) { l = append(l, k) })
+	return l
+}
+
+func (d *ConcurrentDict[K, V]) Values() []V {
+	l := make([]V, 0, d.Len())
+	d.EachKey(func(_ K, v V) { l = append(l, v) })
+	return l
+}
+
+func (d *ConcurrentDict[K, V]) ToDict() Dict[K, V] {
+	result := make(Dict[K, V], d.Len())
+	d.EachKey(func(k K, v V) { result[k] = v })
+	return result
+}
+
+// state
+
+func (d *ConcurrentDict[K, V]) IsEmpty() bool {
+	for _, shard := range d.allShards() {
+		shard.RLock()
+		size := len(shard.items)
+		shard.RUnlock()
+		if size > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *ConcurrentDict[K, V]) AllOf(predicate util.Predicate[V]) bool {
+	_, found := d.FindIfNot(predicate)
+	return !found
+}
+
+func (d *ConcurrentDict[K, V]) AllKeyOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfNotKey(predicate)
+	return !found
+}
+
+func (d *ConcurrentDict[K, V]) AnyOf(predicate util.Predicate[V]) bool {
+	_, found := d.FindIf(predicate)
+	return found
+}
+
+func (d *ConcurrentDict[K, V]) AnyKeyOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfKey(predicate)
+	return found
+}
+
+func (d *ConcurrentDict[K, V]) NoneOf(predicate util.Predicate[V]) bool {
+	return !d.AnyOf(predicate)
+}
+
+func (d *ConcurrentDict[K, V]) NoKeyOf(predicate util.BiPredicate[K, V]) bool {
+	return !d.AnyKeyOf(predicate)
+}
+
+// each
+
+func (d *ConcurrentDict[K, V]) Each(consumer util.Consumer[V]) {
+	d.EachKey(util.ConsumeOnSecondArg[K](consumer))
+}
+
+func (d *ConcurrentDict[K, V]) EachKey(consumer util.BiConsumer[K, V]) {
+	d.EachKeyWhile(func(k K, v V) bool {
+		consumer(k, v)
+		return true
+	})
+}
+
+func (d *ConcurrentDict[K, V]) EachWhile(consumer util.Predicate[V]) bool {
+	return d.EachKeyWhile(util.TestOnSecondArg[K](consumer))
+}
+
+func (d *ConcurrentDict[K, V]) EachKeyWhile(consumer util.BiPredicate[K, V]) bool {
+	for _, shard := range d.allShards() {
+		shard.RLock()
+		snapshot := shard.items.Copy()
+		shard.RUnlock()
+		if !EachKeyWhile(snapshot, consumer) {
+			return false
+		}
+	}
+	return true
+}
+
+// find
+
+func (d *ConcurrentDict[K, V]) FindKey(key K) bool {
+	_, found := d.FindValueFromKey(key)
+	return found
+}
+
+func (d *ConcurrentDict[K, V]) FindValueFromKey(key K) (V, bool) {
+	shard := d.shardOf(key)
+	shard.RLock()
+	defer shard.RUnlock()
+	value, found := shard.items[key]
+	return value, found
+}
+
+func (d *ConcurrentDict[K, V]) Find(value V) bool {
+	_, found := d.FindKeyFromValue(value)
+	return found
+}
+
+func (d *ConcurrentDict[K, V]) FindKeyFromValue(value V) (K, bool) {
+	key, _, found := d.FindIfKey(func(_ K, v V) bool { return util.Equal(v, value) })
+	return key, found
+}
+
+func (d *ConcurrentDict[K, V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	_, v, found := d.FindIfKey(util.TestOnSecondArg[K](predicate))
+	return v, found
+}
+
+func (d *ConcurrentDict[K, V]) FindIfKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	var key K
+	var value V
+	found := !d.EachKeyWhile(func(k K, v V) bool {
+		if predicate(k, v) {
+			key, value = k, v
+			return false
+		}
+		return true
+	})
+	return key, value, found
+}
+
+func (d *ConcurrentDict[K, V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return d.FindIf(util.Not(predicate))
+}
+
+func (d *ConcurrentDict[K, V]) FindIfNotKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	return d.FindIfKey(util.BiNot(predicate))
+}
+
+// copy
+
+func (d *ConcurrentDict[K, V]) Copy() *ConcurrentDict[K, V] {
+	return d.CopyIfKey(util.BiTrue[K, V]())
+}
+
+func (d *ConcurrentDict[K, V]) CopyIf(predicate util.Predicate[V]) *ConcurrentDict[K, V] {
+	return d.CopyIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *ConcurrentDict[K, V]) CopyIfKey(predicate util.BiPredicate[K, V]) *ConcurrentDict[K, V] {
+	shards := d.allShards()
+	copy := NewConcurrentFn[K, V](len(shards), d.hash)
+	for i, shard := range shards {
+		shard.RLock()
+		snapshot := shard.items.Copy()
+		shard.RUnlock()
+		// keys keep the same shard, as the copy shares the hash and the number of shards
+		copy.shards[i].items = snapshot.CopyIfKey(predicate)
+	}
+	return copy
+}
+
+func (d *ConcurrentDict[K, V]) CopyIfNot(predicate util.Predicate[V]) *ConcurrentDict[K, V] {
+	return d.CopyIf(util.Not(predicate))
+}
+
+func (d *ConcurrentDict[K, V]) CopyIfNotKey(predicate util.BiPredicate[K, V]) *ConcurrentDict[K, V] {
+	return d.CopyIfKey(util.BiNot(predicate))
+}
+
+// modifier
+
+func (d *ConcurrentDict[K, V]) Add(key K, value V) bool {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	return shard.items.Add(key, value)
+}
+
+func (d *ConcurrentDict[K, V]) Remove(key K) bool {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	return shard.items.Remove(key)
+}
+
+func (d *ConcurrentDict[K, V]) Clear() bool {
+	updated := false
+	for _, shard := range d.allShards() {
+		shard.Lock()
+		if shard.items.Clear() {
+			updated = true
+		}
+		shard.Unlock()
+	}
+	return updated
+}
+
+func (d *ConcurrentDict[K, V]) RemoveIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *ConcurrentDict[K, V]) RemoveIfKey(predicate util.BiPredicate[K, V]) bool {
+	updated := false
+	for _, shard := range d.allShards() {
+		shard.Lock()
+		if shard.items.RemoveIfKey(predicate) {
+			updated = true
+		}
+		shard.Unlock()
+	}
+	return updated
+}
+
+func (d *ConcurrentDict[K, V]) KeepIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIf(util.Not(predicate))
+}
+
+func (d *ConcurrentDict[K, V]) KeepIfKey(predicate util.BiPredicate[K, V]) bool {
+	return d.RemoveIfKey(util.BiNot(predicate))
+}
+
+// atomic
+
+// LoadOrStore returns the existing value of the key if present, otherwise it stores and returns the given value
+func (d *ConcurrentDict[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	if actual, found := shard.items[key]; found {
+		return actual, true
+	}
+	shard.items[key] = value
+	return value, false
+}
+
+// CompareAndSwap replaces the value of the key by new, only if its current value is old
+func (d *ConcurrentDict[K, V]) CompareAndSwap(key K, old V, new V) bool {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	if current, found := shard.items[key]; found && current == old {
+		shard.items[key] = new
+		return true
+	}
+	return false
+}
+
+// CompareAndDelete removes the key, only if its current value is old
+func (d *ConcurrentDict[K, V]) CompareAndDelete(key K, old V) bool {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	if current, found := shard.items[key]; found && current == old {
+		delete(shard.items, key)
+		return true
+	}
+	return false
+}
+
+// Compute sets the value of the key to the result of the function, or removes the key if the function returns false
+func (d *ConcurrentDict[K, V]) Compute(key K, compute func(key K, old V, found bool) (V, bool)) (V, bool) {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	old, found := shard.items[key]
+	value, keep := compute(key, old, found)
+	if !keep {
+		delete(shard.items, key)
+		var noValue V
+		return noValue, false
+	}
+	shard.items[key] = value
+	return value, true
+}
+
+// ComputeIfAbsent stores the result of the supplier if the key is absent, and returns the value of the key
+func (d *ConcurrentDict[K, V]) ComputeIfAbsent(key K, supplier util.Transformer[K, V]) V {
+	value, _ := d.Compute(key, func(k K, old V, found bool) (V, bool) {
+		if found {
+			return old, true
+		}
+		return supplier(k), true
+	})
+	return value
+}
+
+// ComputeIfPresent sets the value of the key to the result of the function if the key is present,
+// or removes the key if the function returns false
+func (d *ConcurrentDict[K, V]) ComputeIfPresent(key K, compute func(key K, old V) (V, bool)) (V, bool) {
+	shard := d.shardOf(key)
+	shard.Lock()
+	defer shard.Unlock()
+	old, found := shard.items[key]
+	if !found {
+		var noValue V
+		return noValue, false
+	}
+	value, keep := compute(key, old)
+	if !keep {
+		delete(shard.items, key)
+		var noValue V
+		return noValue, false
+	}
+	shard.items[key] = value
+	return value, true
+}
+
+// Update replaces the value of the key by the result of the transformer, if the key is present
+func (d *ConcurrentDict[K, V]) Update(key K, transformer util.Transformer[V, V]) bool {
+	_, found := d.ComputeIfPresent(key, func(_ K, old V) (V, bool) { return transformer(old), true })
+	return found
+}
+
+// internal
+
+func (d *ConcurrentDict[K, V]) setup(shards int, hash util.HashFunc[K]) {
+	if shards <= 0 {
+		shards = DefaultShards
+	}
+	// round up to a power of 2, so that the shard is selected by masking the hash
+	size := 1
+	for size < shards {
+		size <<= 1
+	}
+	if hash == nil {
+		hash = comparableHash[K](maphash.MakeSeed())
+	}
+	d.hash = hash
+	d.shards = make([]*concurrentShard[K, V], size)
+	d.mask = uint64(size - 1)
+	for i := range d.shards {
+		d.shards[i] = &concurrentShard[K, V]{items: Dict[K, V]{}}
+	}
+}
+
+// allShards sets up the zero value on first use
+func (d *ConcurrentDict[K, V]) allShards() []*concurrentShard[K, V] {
+	d.once.Do(func() { d.setup(DefaultShards, nil) })
+	return d.shards
+}
+
+func (d *ConcurrentDict[K, V]) shardOf(key K) *concurrentShard[K, V] {
+	shards := d.allShards()
+	return shards[d.hash(key)&d.mask]
+}
+
+// comparableHash is the default hash of the concurrent dictionary: fast path for strings and integers,
+// reflection for the other comparable types
+func comparableHash[K comparable](seed maphash.Seed) util.HashFunc[K] {
+	return func(key K) uint64 {
+		switch k := any(key).(type) {
+		case string:
+			return maphash.String(seed, k)
+		case int:
+			return mix(uint64(k))
+		case int32:
+			return mix(uint64(k))
+		case int64:
+			return mix(uint64(k))
+		case uint:
+			return mix(uint64(k))
+		case uint32:
+			return mix(uint64(k))
+		case uint64:
+			return mix(k)
+		default:
+			return util.ComparableHash(key)
+		}
+	}
+}
+
+// mix spreads the bits of an integer (splitmix64 finalizer)
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,271 @@
+package dict_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestConcurrentDict(t *testing.T) {
+
+	// builder
+
+	d := dict.NewConcurrent[int, Item](4).
+		With(10, Item{Value: 21}).
+		With(20, Item{Value: 22})
+
+	// add
+
+	require.False(t, d.Add(30, Item{Value: 15}), "add 30-15")
+	require.True(t, d.Add(30, Item{Value: 17}), "override 30-17")
+
+	// remove
+
+	require.True(t, d.Remove(10), "remove 10")
+	require.False(t, d.Remove(10), "remove 10 twice")
+
+	// map[20:22,30:17]
+
+	// predicate
+
+	require.True(t, d.AllOf(func(i Item) bool { return i.Value < 30 }), "all_of")
+	require.False(t, d.AllOf(func(i Item) bool { return i.Value < 10 }), "all_of")
+	require.True(t, d.NoneOf(func(i Item) bool { return i.Value < 10 }), "none_of")
+	require.False(t, d.AnyOf(func(i Item) bool { return i.Value < 10 }), "any_of")
+
+	// find
+
+	require.True(t, d.FindKey(30), "find 30")
+	require.False(t, d.FindKey(50), "find 50")
+	require.True(t, d.Find(Item{Value: 22}), "find 22")
+
+	item, found := d.FindIf(func(i Item) bool { return i.Value%2 == 0 })
+	require.Equal(t, Item{Value: 22}, item, "odd item")
+	require.True(t, found, "found odd")
+
+	key, found := d.FindKeyFromValue(Item{Value: 17})
+	require.Equal(t, 30, key, "key of 17")
+	require.True(t, found, "found 17")
+
+	// range
+
+	var sum int64
+	d.Each(func(i Item) { sum += i.Value })
+	require.Equal(t, int64(22+17), sum, "sum - each")
+
+	// each can modify the dictionary
+
+	d.EachKey(func(k int, i Item) { d.Add(k+1, i) })
+	require.Equal(t, 4, d.Len(), "len after add in each")
+	d.RemoveIfKey(func(k int, _ Item) bool { return k%10 != 0 })
+
+	// remove if
+
+	odds := d.Copy()
+	updated := odds.RemoveIf(func(item Item) bool { return item.Value%2 == 1 })
+	require.True(t, updated, "wrong updated!")
+	assertEqual(t, dict.Dict[int, Item]{20: {Value: 22}}, odds.ToDict(), "wrong odds")
+
+	// keep if
+
+	evens := d.Copy()
+	updated = evens.KeepIf(func(item Item) bool { return item.Value%2 == 1 })
+	require.True(t, updated, "wrong updated!")
+	assertEqual(t, dict.Dict[int, Item]{30: {Value: 17}}, evens.ToDict(), "wrong evens")
+
+	// check source of copy
+
+	assertEqual(t, dict.Dict[int, Item]{20: {Value: 22}, 30: {Value: 17}}, d.ToDict(), "source of copy has been modified")
+
+	// clear
+
+	require.Equal(t, 2, d.Len(), "len before clear")
+	require.False(t, d.IsEmpty(), "is_empty before clear")
+	require.True(t, d.Clear(), "wrong updated!")
+	require.True(t, d.IsEmpty(), "is_empty after clear")
+	require.False(t, d.Clear(), "wrong updated!")
+}
+
+func TestConcurrentDictAtomic(t *testing.T) {
+
+	d := dict.NewConcurrent[string, int](0)
+
+	// load or store
+
+	value, loaded := d.LoadOrStore("a", 1)
+	require.Equal(t, 1, value, "store a")
+	require.False(t, loaded, "loaded a")
+	value, loaded = d.LoadOrStore("a", 2)
+	require.Equal(t, 1, value, "load a")
+	require.True(t, loaded, "loaded a twice")
+
+	// compare and swap
+
+	require.False(t, d.CompareAndSwap("a", 2, 3), "swap a from 2")
+	require.True(t, d.CompareAndSwap("a", 1, 3), "swap a from 1")
+	require.False(t, d.CompareAndSwap("b", 0, 3), "swap missing b")
+	require.False(t, d.CompareAndDelete("a", 1), "delete a from 1")
+	require.True(t, d.CompareAndDelete("a", 3), "delete a from 3")
+
+	// compute
+
+	value, found := d.Compute("a", func(_ string, old int, found bool) (int, bool) { return old + 10, true })
+	require.Equal(t, 10, value, "compute a")
+	require.True(t, found, "computed a")
+	_, found = d.Compute("a", func(_ string, old int, found bool) (int, bool) { return 0, false })
+	require.False(t, found, "compute removes a")
+	require.False(t, d.FindKey("a"), "a removed")
+
+	require.Equal(t, 5, d.ComputeIfAbsent("b", func(string) int { return 5 }), "compute b if absent")
+	require.Equal(t, 5, d.ComputeIfAbsent("b", func(string) int { return 6 }), "compute b if absent twice")
+
+	value, found = d.ComputeIfPresent("b", func(_ string, old int) (int, bool) { return old * 2, true })
+	require.Equal(t, 10, value, "compute b if present")
+	require.True(t, found, "computed b if present")
+	_, found = d.ComputeIfPresent("c", func(_ string, old int) (int, bool) { return 1, true })
+	require.False(t, found, "compute missing c if present")
+	require.False(t, d.FindKey("c"), "c not added")
+
+	// update
+
+	require.True(t, d.Update("b", func(old int) int { return old + 1 }), "update b")
+	require.False(t, d.Update("c", func(old int) int { return old + 1 }), "update missing c")
+	value, _ = d.FindValueFromKey("b")
+	require.Equal(t, 11, value, "b after update")
+}
+
+func TestConcurrentDictZeroValue(t *testing.T) {
+
+	var d dict.ConcurrentDict[string, int]
+	require.True(t, d.IsEmpty(), "zero value is empty")
+	require.False(t, d.Add("a", 1), "add a")
+	require.True(t, d.FindKey("a"), "find a")
+	assertEqual(t, dict.Dict[string, int]{"a": 1}, d.Copy().ToDict(), "copy of zero value")
+
+	// the first use can be concurrent
+
+	var concurrent dict.ConcurrentDict[int, int]
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			concurrent.Add(g, g)
+		}(g)
+	}
+	wg.Wait()
+	require.Equal(t, 8, concurrent.Len(), "wrong len")
+}
+
+func TestConcurrentDictRace(t *testing.T) {
+
+	const goroutines = 16
+	const increments = 1000
+
+	for name, d := range map[string]*dict.ConcurrentDict[int, int]{
+		"default-hash": dict.NewConcurrent[int, int](8),
+		"custom-hash":  dict.NewConcurrentFn[int, int](8, func(k int) uint64 { return uint64(k) }),
+		"single-shard": dict.NewConcurrent[int, int](1),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					for i := 0; i < increments; i++ {
+						d.Compute(i%10, func(_ int, old int, _ bool) (int, bool) { return old + 1, true })
+						d.Add(100+g, i)
+						d.FindValueFromKey(i % 10)
+						if i%100 == 0 {
+							d.Each(func(int) {})
+							d.Copy()
+						}
+					}
+				}(g)
+			}
+			wg.Wait()
+
+			total := 0
+			d.EachKey(func(k int, v int) {
+				if k < 10 {
+					total += v
+				}
+			})
+			require.Equal(t, goroutines*increments, total, "lost increments")
+			require.Equal(t, 10+goroutines, d.Len(), "wrong len")
+		})
+	}
+}
+
+func TestConcurrentDictPointerKeys(t *testing.T) {
+
+	// pointer keys are hashed by address, so mutating the pointee keeps the key reachable
+
+	key := &Item{Value: 1}
+	d := dict.NewConcurrent[*Item, int](16).With(key, 1)
+	key.Value = 2
+	require.True(t, d.FindKey(key), "find mutated key")
+	require.False(t, d.FindKey(&Item{Value: 2}), "find equal pointee")
+
+	type Composite struct {
+		Name string
+		Item *Item
+	}
+	c := dict.NewConcurrent[Composite, int](16).With(Composite{Name: "a", Item: key}, 1)
+	key.Value = 3
+	require.True(t, c.FindKey(Composite{Name: "a", Item: key}), "find composite key")
+	require.Equal(t, util.ComparableHash(Composite{Name: "a", Item: key}), util.ComparableHash(Composite{Name: "a", Item: key}), "stable hash")
+}
+
+//
+// benchmarks
+//
+
+func BenchmarkConcurrentDict(b *testing.B) {
+	for _, writes := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("concurrent-dict/%d%%-writes", writes), func(b *testing.B) {
+			d := dict.NewConcurrent[int, int](0)
+			for i := 0; i < 1024; i++ {
+				d.Add(i, i)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					key := i & 1023
+					if i%100 < writes {
+						d.Add(key, i)
+					} else {
+						d.FindValueFromKey(key)
+					}
+					i++
+				}
+			})
+		})
+		b.Run(fmt.Sprintf("sync-map/%d%%-writes", writes), func(b *testing.B) {
+			var m sync.Map
+			for i := 0; i < 1024; i++ {
+				m.Store(i, i)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					key := i & 1023
+					if i%100 < writes {
+						m.Store(key, i)
+					} else {
+						m.Load(key)
+					}
+					i++
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func CountBy[K comparable, V any, O comparable, D ~map[K]V](d D, key util.Transformer[V, O]) Dict[O, int] {
+	return CountByKey(d, func(_ K, v V) O { return key(v) })
+}
+
+func CountByKey[K comparable, V any, O comparable, D ~map[K]V](d D, key util.BiTransformer[K, V, O]) Dict[O, int] {
+	if d == nil {
+		return nil
+	}
+	counts := Dict[O, int]{}
+	for k, v := range d {
+		counts[key(k, v)]++
+	}
+	return counts
+}
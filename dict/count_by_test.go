@@ -0,0 +1,61 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestCountByKeyStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      dict.Dict[int, Item]
+		key        util.BiTransformer[int, Item, bool]
+		wantCounts dict.Dict[bool, int]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			key:        func(_ int, i Item) bool { return i.Value%2 == 0 },
+			wantCounts: nil,
+		},
+		"empty": {
+			items:      EmptyItemDict,
+			key:        func(_ int, i Item) bool { return i.Value%2 == 0 },
+			wantCounts: dict.Dict[bool, int]{},
+		},
+		"even-value": {
+			items:      DefaultItemDict,
+			key:        func(_ int, i Item) bool { return i.Value%2 == 0 },
+			wantCounts: dict.Dict[bool, int]{true: 3, false: 2},
+		},
+		"small-key": {
+			items:      DefaultItemDict,
+			key:        func(k int, _ Item) bool { return k < 20 },
+			wantCounts: dict.Dict[bool, int]{true: 1, false: 4},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotCounts := dict.CountByKey(testCase.items, testCase.key)
+
+		// assert
+		require.Equal(t, testCase.wantCounts, gotCounts, "wrong counts!")
+	})
+}
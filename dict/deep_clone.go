@@ -0,0 +1,8 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+// DeepClone recursively copies the dict and its values, see util.DeepClone
+func DeepClone[K comparable, V any, D ~map[K]V](d D) D {
+	return util.DeepClone(d)
+}
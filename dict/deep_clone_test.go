@@ -0,0 +1,36 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+func TestDeepClone(t *testing.T) {
+
+	// values are copied, keys are kept
+
+	key := &Item{Value: 1}
+	d := dict.DeepDict[*Item, []*Item]{key: {{Value: 21}, {Value: 12}}}
+	clone := d.DeepClone()
+
+	require.Equal(t, d, clone, "wrong clone!")
+	require.Contains(t, clone, key, "key not kept")
+	clone[key][0].Value = 99
+	require.Equal(t, int64(21), d[key][0].Value, "value shared")
+
+	// nested trees
+
+	tree := dict.DeepDict[string, any]{
+		"server": dict.DeepDict[string, any]{"ports": []int{80, 443}},
+	}
+	clonedTree := dict.DeepClone(tree)
+	clonedTree["server"].(dict.DeepDict[string, any])["ports"].([]int)[0] = 8080
+	require.Equal(t, 80, tree["server"].(dict.DeepDict[string, any])["ports"].([]int)[0], "nested slice shared")
+
+	// nil
+
+	require.Nil(t, dict.DeepDict[int, []int](nil).DeepClone(), "nil clone")
+}
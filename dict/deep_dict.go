@@ -75,6 +75,44 @@ func (d DeepDict[K, V]) EachKey(consumer util.BiConsumer[K, V]) {
 	EachKey(d, consumer)
 }
 
+func (d DeepDict[K, V]) EachWhile(consumer util.Predicate[V]) bool {
+	return EachWhile(d, consumer)
+}
+
+func (d DeepDict[K, V]) EachKeyWhile(consumer util.BiPredicate[K, V]) bool {
+	return EachKeyWhile(d, consumer)
+}
+
+func (d DeepDict[K, V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return EachErr(d, consumer)
+}
+
+func (d DeepDict[K, V]) EachKeyErr(consumer util.BiErrConsumer[K, V]) error {
+	return EachKeyErr(d, consumer)
+}
+
+// sorted
+
+func (d DeepDict[K, V]) SortedKeys(comparator util.Comparator[K]) []K {
+	return SortedKeys(d, comparator)
+}
+
+func (d DeepDict[K, V]) SortedValues(comparator util.Comparator[V]) []V {
+	return SortedValues(d, comparator)
+}
+
+func (d DeepDict[K, V]) SortedEach(comparator util.Comparator[K], consumer util.Consumer[V]) {
+	SortedEach(d, comparator, consumer)
+}
+
+func (d DeepDict[K, V]) SortedEachKey(comparator util.Comparator[K], consumer util.BiConsumer[K, V]) {
+	SortedEachKey(d, comparator, consumer)
+}
+
+func (d DeepDict[K, V]) EachOrdered(keyOrder []K, consumer util.BiConsumer[K, V]) {
+	EachOrdered(d, keyOrder, consumer)
+}
+
 // find
 
 func (d DeepDict[K, V]) FindKey(key K) bool {
@@ -115,6 +153,10 @@ func (d DeepDict[K, V]) Copy() DeepDict[K, V] {
 	return DeepDict[K, V](Copy(d))
 }
 
+func (d DeepDict[K, V]) DeepClone() DeepDict[K, V] {
+	return DeepClone(d)
+}
+
 func (d DeepDict[K, V]) CopyIf(predicate util.Predicate[V]) DeepDict[K, V] {
 	return DeepDict[K, V](CopyIf(d, predicate))
 }
@@ -131,6 +173,14 @@ func (d DeepDict[K, V]) CopyKeyIfNot(predicate util.BiPredicate[K, V]) DeepDict[
 	return DeepDict[K, V](CopyIfNotKey(d, predicate))
 }
 
+func (d DeepDict[K, V]) Partition(predicate util.Predicate[V]) (DeepDict[K, V], DeepDict[K, V]) {
+	return Partition(d, predicate)
+}
+
+func (d DeepDict[K, V]) PartitionKey(predicate util.BiPredicate[K, V]) (DeepDict[K, V], DeepDict[K, V]) {
+	return PartitionKey(d, predicate)
+}
+
 // modifier
 
 func (d *DeepDict[K, V]) Add(key K, value V) bool {
@@ -170,3 +220,11 @@ func (d *DeepDict[K, V]) KeepIf(predicate util.Predicate[V]) bool {
 func (d *DeepDict[K, V]) KeepIfKey(predicate util.BiPredicate[K, V]) bool {
 	return KeepIfKey(d, predicate)
 }
+
+func (d *DeepDict[K, V]) Merge(other DeepDict[K, V], resolver Resolver[K, V]) {
+	Merge(d, other, resolver)
+}
+
+func (d *DeepDict[K, V]) MergeErr(other DeepDict[K, V], resolver ErrResolver[K, V]) error {
+	return MergeErr(d, other, resolver)
+}
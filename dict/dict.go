@@ -75,6 +75,44 @@ func (d Dict[K, V]) EachKey(consumer util.BiConsumer[K, V]) {
 	EachKey(d, consumer)
 }
 
+func (d Dict[K, V]) EachWhile(consumer util.Predicate[V]) bool {
+	return EachWhile(d, consumer)
+}
+
+func (d Dict[K, V]) EachKeyWhile(consumer util.BiPredicate[K, V]) bool {
+	return EachKeyWhile(d, consumer)
+}
+
+func (d Dict[K, V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return EachErr(d, consumer)
+}
+
+func (d Dict[K, V]) EachKeyErr(consumer util.BiErrConsumer[K, V]) error {
+	return EachKeyErr(d, consumer)
+}
+
+// sorted
+
+func (d Dict[K, V]) SortedKeys(comparator util.Comparator[K]) []K {
+	return SortedKeys(d, comparator)
+}
+
+func (d Dict[K, V]) SortedValues(comparator util.Comparator[V]) []V {
+	return SortedValues(d, comparator)
+}
+
+func (d Dict[K, V]) SortedEach(comparator util.Comparator[K], consumer util.Consumer[V]) {
+	SortedEach(d, comparator, consumer)
+}
+
+func (d Dict[K, V]) SortedEachKey(comparator util.Comparator[K], consumer util.BiConsumer[K, V]) {
+	SortedEachKey(d, comparator, consumer)
+}
+
+func (d Dict[K, V]) EachOrdered(keyOrder []K, consumer util.BiConsumer[K, V]) {
+	EachOrdered(d, keyOrder, consumer)
+}
+
 // find
 
 func (d Dict[K, V]) FindKey(key K) bool {
@@ -131,6 +169,14 @@ func (d Dict[K, V]) CopyIfNotKey(predicate util.BiPredicate[K, V]) Dict[K, V] {
 	return Dict[K, V](CopyIfNotKey(d, predicate))
 }
 
+func (d Dict[K, V]) Partition(predicate util.Predicate[V]) (Dict[K, V], Dict[K, V]) {
+	return Partition(d, predicate)
+}
+
+func (d Dict[K, V]) PartitionKey(predicate util.BiPredicate[K, V]) (Dict[K, V], Dict[K, V]) {
+	return PartitionKey(d, predicate)
+}
+
 // modifier
 
 func (d *Dict[K, V]) Add(key K, value V) bool {
@@ -173,3 +219,11 @@ func (d *Dict[K, V]) KeepIf(predicate util.Predicate[V]) bool {
 func (d *Dict[K, V]) KeepIfKey(predicate util.BiPredicate[K, V]) bool {
 	return KeepIfKey(d, predicate)
 }
+
+func (d *Dict[K, V]) Merge(other Dict[K, V], resolver Resolver[K, V]) {
+	Merge(d, other, resolver)
+}
+
+func (d *Dict[K, V]) MergeErr(other Dict[K, V], resolver ErrResolver[K, V]) error {
+	return MergeErr(d, other, resolver)
+}
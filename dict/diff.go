@@ -0,0 +1,81 @@
+package dict
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// Change is the old and new values of a key present on both sides of a diff
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+// Delta lists the keys added, removed and changed from left to right
+type Delta[K comparable, V any] struct {
+	Added   DeepDict[K, V]
+	Removed DeepDict[K, V]
+	Changed DeepDict[K, Change[V]]
+}
+
+func Diff[K comparable, V comparable, D ~map[K]V](left D, right D) Delta[K, V] {
+	return DiffFn(left, right, util.Equal[V])
+}
+
+func DeepDiff[K comparable, V any, D ~map[K]V](left D, right D) Delta[K, V] {
+	return DiffFn(left, right, util.DeepEqual[V])
+}
+
+func DiffFn[K comparable, V any, D ~map[K]V](left D, right D, equal util.BiPredicate[V, V]) Delta[K, V] {
+	delta := Delta[K, V]{
+		Added:   DeepDict[K, V]{},
+		Removed: DeepDict[K, V]{},
+		Changed: DeepDict[K, Change[V]]{},
+	}
+	for k, leftValue := range left {
+		rightValue, found := right[k]
+		if !found {
+			delta.Removed[k] = leftValue
+		} else if !equal(leftValue, rightValue) {
+			delta.Changed[k] = Change[V]{Old: leftValue, New: rightValue}
+		}
+	}
+	for k, rightValue := range right {
+		if _, found := left[k]; !found {
+			delta.Added[k] = rightValue
+		}
+	}
+	return delta
+}
+
+func (d Delta[K, V]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String renders one line per key, sorted by key: "+ key: value", "- key: value" or "~ key: old -> new"
+func (d Delta[K, V]) String() string {
+	type line struct {
+		key  string
+		text string
+	}
+	lines := make([]line, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	for k, v := range d.Added {
+		lines = append(lines, line{key: fmt.Sprint(k), text: fmt.Sprintf("+ %v: %v", k, v)})
+	}
+	for k, v := range d.Removed {
+		lines = append(lines, line{key: fmt.Sprint(k), text: fmt.Sprintf("- %v: %v", k, v)})
+	}
+	for k, c := range d.Changed {
+		lines = append(lines, line{key: fmt.Sprint(k), text: fmt.Sprintf("~ %v: %v -> %v", k, c.Old, c.New)})
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i].key < lines[j].key })
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(l.text)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
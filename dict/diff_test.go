@@ -0,0 +1,85 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestDiffInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		left      dict.Dict[int, int64]
+		right     dict.Dict[int, int64]
+		wantDelta dict.Delta[int, int64]
+		wantText  string
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			left:  nil,
+			right: nil,
+			wantDelta: dict.Delta[int, int64]{
+				Added:   dict.DeepDict[int, int64]{},
+				Removed: dict.DeepDict[int, int64]{},
+				Changed: dict.DeepDict[int, dict.Change[int64]]{},
+			},
+			wantText: "",
+		},
+		"equal": {
+			left:  DefaultInt64Dict,
+			right: DefaultInt64Dict,
+			wantDelta: dict.Delta[int, int64]{
+				Added:   dict.DeepDict[int, int64]{},
+				Removed: dict.DeepDict[int, int64]{},
+				Changed: dict.DeepDict[int, dict.Change[int64]]{},
+			},
+			wantText: "",
+		},
+		"with-other": {
+			left:  DefaultInt64Dict,
+			right: dict.Dict[int, int64]{10: 21, 20: 13, 40: 87, 50: 52, 60: 69},
+			wantDelta: dict.Delta[int, int64]{
+				Added:   dict.DeepDict[int, int64]{60: 69},
+				Removed: dict.DeepDict[int, int64]{30: 34},
+				Changed: dict.DeepDict[int, dict.Change[int64]]{20: {Old: 12, New: 13}},
+			},
+			wantText: "~ 20: 12 -> 13\n- 30: 34\n+ 60: 69\n",
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		delta := dict.Diff(testCase.left, testCase.right)
+
+		// assert
+		require.Equal(t, testCase.wantDelta, delta, "wrong delta!")
+		require.Equal(t, testCase.wantText == "", delta.IsEmpty(), "wrong is_empty!")
+		require.Equal(t, testCase.wantText, delta.String(), "wrong text!")
+	})
+}
+
+func TestDeepDiffStruct(t *testing.T) {
+
+	left := dict.Dict[int, *Item]{10: {Value: 21}, 20: {Value: 12}}
+	right := dict.Dict[int, *Item]{10: {Value: 21}, 20: {Value: 13}}
+
+	delta := dict.DeepDiff(left, right)
+	require.Empty(t, delta.Added, "wrong added!")
+	require.Empty(t, delta.Removed, "wrong removed!")
+	require.Equal(t, dict.DeepDict[int, dict.Change[*Item]]{20: {Old: left[20], New: right[20]}}, delta.Changed, "wrong changed!")
+	require.Equal(t, "~ 20: { value: 12 } -> { value: 13 }\n", delta.String(), "wrong text!")
+}
@@ -0,0 +1,16 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func EachErr[K comparable, V any, D ~map[K]V](d D, consumer util.ErrConsumer[V]) error {
+	return EachKeyErr(d, func(_ K, v V) error { return consumer(v) })
+}
+
+func EachKeyErr[K comparable, V any, D ~map[K]V](d D, consumer util.BiErrConsumer[K, V]) error {
+	for k, v := range d {
+		if err := consumer(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package dict_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestEachErrInt64(t *testing.T) {
+
+	errStop := errors.New("stop")
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       dict.Dict[int, int64]
+		limit       int
+		wantErr     error
+		wantVisited int
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			limit:       2,
+			wantErr:     nil,
+			wantVisited: 0,
+		},
+		"empty": {
+			items:       EmptyInt64Dict,
+			limit:       2,
+			wantErr:     nil,
+			wantVisited: 0,
+		},
+		"stop": {
+			items:       DefaultInt64Dict,
+			limit:       2,
+			wantErr:     errStop,
+			wantVisited: 2,
+		},
+		"all": {
+			items:       DefaultInt64Dict,
+			limit:       10,
+			wantErr:     nil,
+			wantVisited: 5,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotVisited := 0
+		gotErr := dict.EachErr(testCase.items, func(item int64) error {
+			gotVisited++
+			if gotVisited >= testCase.limit {
+				return errStop
+			}
+			return nil
+		})
+
+		// assert
+		require.Equal(t, testCase.wantErr, gotErr, "wrong error!")
+		require.Equal(t, testCase.wantVisited, gotVisited, "wrong visited!")
+	})
+}
+
+func TestEachKeyErrStructPointer(t *testing.T) {
+
+	err := dict.DeepDict[int, *Item](DefaultItemPointerDict).EachKeyErr(func(k int, item *Item) error {
+		if item.Value == 87 {
+			return fmt.Errorf("item %d: %d", k, item.Value)
+		}
+		return nil
+	})
+	require.EqualError(t, err, "item 40: 87", "wrong error!")
+}
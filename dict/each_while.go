@@ -0,0 +1,16 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func EachWhile[K comparable, V any, D ~map[K]V](d D, consumer util.Predicate[V]) bool {
+	return EachKeyWhile(d, util.TestOnSecondArg[K](consumer))
+}
+
+func EachKeyWhile[K comparable, V any, D ~map[K]V](d D, consumer util.BiPredicate[K, V]) bool {
+	for k, v := range d {
+		if !consumer(k, v) {
+			return false
+		}
+	}
+	return true
+}
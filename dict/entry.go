@@ -0,0 +1,7 @@
+package dict
+
+// Entry is a key-value pair
+type Entry[K any, V any] struct {
+	Key   K
+	Value V
+}
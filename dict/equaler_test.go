@@ -0,0 +1,90 @@
+package dict_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+// Label implements util.Equaler, ignoring the case of its words
+type Label struct {
+	Words []string
+}
+
+func (l Label) Equal(other Label) bool {
+	if len(l.Words) != len(other.Words) {
+		return false
+	}
+	for i, word := range l.Words {
+		if !strings.EqualFold(word, other.Words[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// PlainLabel is the same structure, compared through reflection
+type PlainLabel struct {
+	Words []string
+}
+
+func TestEqualer(t *testing.T) {
+
+	labels := dict.DeepDict[int, Label]{
+		10: {Words: []string{"hello", "world"}},
+		20: {Words: []string{"foo"}},
+	}
+
+	// find
+
+	require.True(t, labels.Find(Label{Words: []string{"Hello", "World"}}), "find uses equaler")
+	require.False(t, labels.Find(Label{Words: []string{"hello"}}), "find other words")
+
+	key, found := labels.FindKeyFromValue(Label{Words: []string{"FOO"}})
+	require.True(t, found, "find key uses equaler")
+	require.Equal(t, 20, key, "key of foo")
+
+	// equal
+
+	require.True(t, dict.DeepEqual(labels, dict.DeepDict[int, Label]{
+		10: {Words: []string{"HELLO", "WORLD"}},
+		20: {Words: []string{"Foo"}},
+	}), "equal uses equaler")
+
+	// fallback on reflection
+
+	plains := dict.DeepDict[int, PlainLabel]{10: {Words: []string{"hello"}}}
+	require.True(t, plains.Find(PlainLabel{Words: []string{"hello"}}), "find through reflection")
+	require.False(t, plains.Find(PlainLabel{Words: []string{"Hello"}}), "reflection is case sensitive")
+}
+
+//
+// benchmarks
+//
+
+func BenchmarkEqualer(b *testing.B) {
+	const size = 1000
+	labels := dict.DeepDict[int, Label]{}
+	plains := dict.DeepDict[int, PlainLabel]{}
+	for i := 0; i < size; i++ {
+		labels[i] = Label{Words: []string{"label", fmt.Sprint(i)}}
+		plains[i] = PlainLabel{Words: []string{"label", fmt.Sprint(i)}}
+	}
+	missingLabel := Label{Words: []string{"label", "missing"}}
+	missingPlain := PlainLabel{Words: []string{"label", "missing"}}
+
+	b.Run("find/equaler", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			labels.Find(missingLabel)
+		}
+	})
+	b.Run("find/reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			plains.Find(missingPlain)
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func GroupBy[K comparable, V any, O comparable, D ~map[K]V](d D, key util.Transformer[V, O]) DeepDict[O, D] {
+	return GroupByKey(d, func(_ K, v V) O { return key(v) })
+}
+
+func GroupByKey[K comparable, V any, O comparable, D ~map[K]V](d D, key util.BiTransformer[K, V, O]) DeepDict[O, D] {
+	if d == nil {
+		return nil
+	}
+	groups := DeepDict[O, D]{}
+	for k, v := range d {
+		o := key(k, v)
+		group, found := groups[o]
+		if !found {
+			group = D{}
+			groups[o] = group
+		}
+		group[k] = v
+	}
+	return groups
+}
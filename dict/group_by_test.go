@@ -0,0 +1,71 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestGroupByInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      dict.Dict[int, int64]
+		key        util.Transformer[int64, int64]
+		wantGroups dict.DeepDict[int64, dict.Dict[int, int64]]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			key:        func(i int64) int64 { return i % 3 },
+			wantGroups: nil,
+		},
+		"empty": {
+			items:      EmptyInt64Dict,
+			key:        func(i int64) int64 { return i % 3 },
+			wantGroups: dict.DeepDict[int64, dict.Dict[int, int64]]{},
+		},
+		"modulo-3": {
+			items: DefaultInt64Dict,
+			key:   func(i int64) int64 { return i % 3 },
+			wantGroups: dict.DeepDict[int64, dict.Dict[int, int64]]{
+				0: {10: 21, 20: 12, 40: 87},
+				1: {30: 34, 50: 52},
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotGroups := dict.GroupBy(testCase.items, testCase.key)
+
+		// assert
+		require.Equal(t, testCase.wantGroups, gotGroups, "wrong groups!")
+	})
+}
+
+func TestGroupByKeyStruct(t *testing.T) {
+
+	// execute
+	gotGroups := dict.GroupByKey(DefaultItemDict, func(k int, _ Item) bool { return k < 30 })
+
+	// assert
+	require.Equal(t, dict.DeepDict[bool, dict.Dict[int, Item]]{
+		true:  {10: {Value: 21}, 20: {Value: 12}},
+		false: {30: {Value: 34}, 40: {Value: 87}, 50: {Value: 52}},
+	}, gotGroups, "wrong groups!")
+}
@@ -0,0 +1,120 @@
+package dict
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// json
+//
+// note:
+//   - dicts with string, integer or text marshaler keys are encoded as a JSON object, like a plain map
+//   - dicts with other keys are encoded as an array of [key, value] pairs, sorted by encoded key
+//   - both forms are accepted when decoding
+
+func (d Dict[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(map[K]V(d))
+}
+
+func (d *Dict[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*map[K]V)(d))
+}
+
+func (d DeepDict[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(map[K]V(d))
+}
+
+func (d *DeepDict[K, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*map[K]V)(d))
+}
+
+// internal
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// isTextKey tells if encoding/json can encode and decode the keys as object names
+func isTextKey[K comparable]() bool {
+	keyType := reflect.TypeOf((*K)(nil)).Elem()
+	if keyType.Implements(textMarshalerType) && reflect.PointerTo(keyType).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch keyType.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func marshalJSON[K comparable, V any](m map[K]V) ([]byte, error) {
+	if m == nil || isTextKey[K]() {
+		return json.Marshal(m)
+	}
+	type pair struct {
+		key   json.RawMessage
+		value json.RawMessage
+	}
+	pairs := make([]pair, 0, len(m))
+	for k, v := range m {
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair{key: key, value: value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return bytes.Compare(pairs[i].key, pairs[j].key) < 0 })
+	encoded := make([][2]json.RawMessage, len(pairs))
+	for i, p := range pairs {
+		encoded[i] = [2]json.RawMessage{p.key, p.value}
+	}
+	return json.Marshal(encoded)
+}
+
+func unmarshalJSON[K comparable, V any](data []byte, m *map[K]V) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		*m = nil
+		return nil
+	}
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		decoded := map[K]V{}
+		if err := json.Unmarshal(trimmed, &decoded); err != nil {
+			return err
+		}
+		*m = decoded
+		return nil
+	}
+	var pairs [][]json.RawMessage
+	if err := json.Unmarshal(trimmed, &pairs); err != nil {
+		return err
+	}
+	decoded := make(map[K]V, len(pairs))
+	for i, p := range pairs {
+		if len(p) != 2 {
+			return fmt.Errorf("json: pair %d has %d elements instead of 2", i, len(p))
+		}
+		var key K
+		if err := json.Unmarshal(p[0], &key); err != nil {
+			return err
+		}
+		var value V
+		if err := json.Unmarshal(p[1], &value); err != nil {
+			return err
+		}
+		decoded[key] = value
+	}
+	*m = decoded
+	return nil
+}
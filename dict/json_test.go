@@ -0,0 +1,72 @@
+package dict_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func TestJSONTextKeys(t *testing.T) {
+
+	// int keys are encoded as an object, like a plain map
+
+	data, err := json.Marshal(DefaultInt64Dict)
+	require.NoError(t, err, "marshal")
+	require.JSONEq(t, `{"10":21,"20":12,"30":34,"40":87,"50":52}`, string(data), "wrong json!")
+
+	var decoded dict.Dict[int, int64]
+	require.NoError(t, json.Unmarshal(data, &decoded), "unmarshal")
+	assertEqual(t, DefaultInt64Dict, decoded, "wrong round trip!")
+
+	// nil
+
+	data, err = json.Marshal(dict.Dict[int, int64](nil))
+	require.NoError(t, err, "marshal nil")
+	require.Equal(t, "null", string(data), "wrong nil json!")
+	require.NoError(t, json.Unmarshal(data, &decoded), "unmarshal nil")
+	require.Nil(t, decoded, "wrong nil round trip!")
+}
+
+func TestJSONStructKeys(t *testing.T) {
+
+	d := dict.DeepDict[Point, []string]{
+		{X: 1, Y: 2}: {"a", "b"},
+		{X: 0, Y: 5}: nil,
+	}
+
+	// struct keys are encoded as [key, value] pairs sorted by key
+
+	data, err := json.Marshal(d)
+	require.NoError(t, err, "marshal")
+	require.Equal(t, `[[{"x":0,"y":5},null],[{"x":1,"y":2},["a","b"]]]`, string(data), "wrong json!")
+
+	var decoded dict.DeepDict[Point, []string]
+	require.NoError(t, json.Unmarshal(data, &decoded), "unmarshal")
+	require.Equal(t, d, decoded, "wrong round trip!")
+
+	// nested in a struct
+
+	type Wrapper struct {
+		Flags dict.Dict[bool, int] `json:"flags"`
+	}
+	data, err = json.Marshal(Wrapper{Flags: dict.Dict[bool, int]{true: 1, false: 0}})
+	require.NoError(t, err, "marshal wrapper")
+	require.Equal(t, `{"flags":[[false,0],[true,1]]}`, string(data), "wrong wrapper json!")
+
+	var wrapper Wrapper
+	require.NoError(t, json.Unmarshal(data, &wrapper), "unmarshal wrapper")
+	assertEqual(t, dict.Dict[bool, int]{true: 1, false: 0}, wrapper.Flags, "wrong wrapper round trip!")
+
+	// invalid pairs
+
+	require.Error(t, json.Unmarshal([]byte(`[[true]]`), &wrapper.Flags), "pair with one element")
+	require.Error(t, json.Unmarshal([]byte(`[["a",1]]`), &wrapper.Flags), "pair with a wrong key")
+}
@@ -0,0 +1,25 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func MapKeys[K comparable, V any, O comparable, D ~map[K]V](d D, transformer util.Transformer[K, O], resolver Resolver[O, V]) DeepDict[O, V] {
+	return MapKeysValue(d, func(k K, _ V) O { return transformer(k) }, resolver)
+}
+
+func MapKeysValue[K comparable, V any, O comparable, D ~map[K]V](d D, transformer util.BiTransformer[K, V, O], resolver Resolver[O, V]) DeepDict[O, V] {
+	if d == nil {
+		return nil
+	}
+	// note:
+	//  - the map iteration order is random, so the resolver should not depend on the order of old and new
+	//  - a nil resolver keeps the last value visited
+	mapped := make(DeepDict[O, V], len(d))
+	for k, v := range d {
+		key := transformer(k, v)
+		if old, found := mapped[key]; found && resolver != nil {
+			v = resolver(key, old, v)
+		}
+		mapped[key] = v
+	}
+	return mapped
+}
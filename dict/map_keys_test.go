@@ -0,0 +1,142 @@
+package dict_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestMapKeysInt64(t *testing.T) {
+
+	sum := func(_ int, old int64, new int64) int64 { return old + new }
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       dict.Dict[int, int64]
+		transformer util.Transformer[int, int]
+		resolver    dict.Resolver[int, int64]
+		wantItems   dict.DeepDict[int, int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(k int) int { return k / 20 },
+			resolver:    sum,
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyInt64Dict,
+			transformer: func(k int) int { return k / 20 },
+			resolver:    sum,
+			wantItems:   dict.DeepDict[int, int64]{},
+		},
+		"no-collision": {
+			items:       DefaultInt64Dict,
+			transformer: func(k int) int { return k + 1 },
+			resolver:    sum,
+			wantItems: dict.DeepDict[int, int64]{
+				11: 21,
+				21: 12,
+				31: 34,
+				41: 87,
+				51: 52,
+			},
+		},
+		"collision-sum": {
+			items:       DefaultInt64Dict,
+			transformer: func(k int) int { return k / 20 },
+			resolver:    sum,
+			wantItems: dict.DeepDict[int, int64]{
+				0: 21,
+				1: 12 + 34,
+				2: 87 + 52,
+			},
+		},
+		"collision-max": {
+			items:       DefaultInt64Dict,
+			transformer: func(k int) int { return k / 20 },
+			resolver: func(_ int, old int64, new int64) int64 {
+				if old > new {
+					return old
+				}
+				return new
+			},
+			wantItems: dict.DeepDict[int, int64]{
+				0: 21,
+				1: 34,
+				2: 87,
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := dict.MapKeys(testCase.items, testCase.transformer, testCase.resolver)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestMapKeysValueStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       dict.Dict[int, Item]
+		transformer util.BiTransformer[int, Item, int64]
+		wantItems   dict.DeepDict[int64, Item]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(_ int, item Item) int64 { return item.Value },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyItemDict,
+			transformer: func(_ int, item Item) int64 { return item.Value },
+			wantItems:   dict.DeepDict[int64, Item]{},
+		},
+		"index-by-value": {
+			items:       DefaultItemDict,
+			transformer: func(_ int, item Item) int64 { return item.Value },
+			wantItems: dict.DeepDict[int64, Item]{
+				21: {Value: 21},
+				12: {Value: 12},
+				34: {Value: 34},
+				87: {Value: 87},
+				52: {Value: 52},
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := dict.MapKeysValue(testCase.items, testCase.transformer, nil)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
@@ -0,0 +1,18 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func MapValues[K comparable, V any, O any, D ~map[K]V](d D, transformer util.Transformer[V, O]) DeepDict[K, O] {
+	return MapValuesKey(d, func(_ K, v V) O { return transformer(v) })
+}
+
+func MapValuesKey[K comparable, V any, O any, D ~map[K]V](d D, transformer util.BiTransformer[K, V, O]) DeepDict[K, O] {
+	if d == nil {
+		return nil
+	}
+	mapped := make(DeepDict[K, O], len(d))
+	for k, v := range d {
+		mapped[k] = transformer(k, v)
+	}
+	return mapped
+}
@@ -0,0 +1,112 @@
+package dict_test
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestMapValuesInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       dict.Dict[int, int64]
+		transformer util.Transformer[int64, string]
+		wantItems   dict.DeepDict[int, string]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(i int64) string { return fmt.Sprint(i) },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyInt64Dict,
+			transformer: func(i int64) string { return fmt.Sprint(i) },
+			wantItems:   dict.DeepDict[int, string]{},
+		},
+		"to-string": {
+			items:       DefaultInt64Dict,
+			transformer: func(i int64) string { return fmt.Sprint(i) },
+			wantItems: dict.DeepDict[int, string]{
+				10: "21",
+				20: "12",
+				30: "34",
+				40: "87",
+				50: "52",
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := dict.MapValues(testCase.items, testCase.transformer)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestMapValuesKeyStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       dict.Dict[int, Item]
+		transformer util.BiTransformer[int, Item, int64]
+		wantItems   dict.DeepDict[int, int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(k int, item Item) int64 { return int64(k) + item.Value },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyItemDict,
+			transformer: func(k int, item Item) int64 { return int64(k) + item.Value },
+			wantItems:   dict.DeepDict[int, int64]{},
+		},
+		"key-plus-value": {
+			items:       DefaultItemDict,
+			transformer: func(k int, item Item) int64 { return int64(k) + item.Value },
+			wantItems: dict.DeepDict[int, int64]{
+				10: 31,
+				20: 32,
+				30: 64,
+				40: 127,
+				50: 102,
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := dict.MapValuesKey(testCase.items, testCase.transformer)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
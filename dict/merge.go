@@ -0,0 +1,67 @@
+package dict
+
+// Merge adds the entries of src into dst, calling the resolver when a key is in both
+//
+// note: a nil resolver keeps the value of src
+func Merge[K comparable, V any, D ~map[K]V](dst *D, src D, resolver Resolver[K, V]) {
+	if resolver == nil {
+		resolver = KeepLast[K, V]
+	}
+	_ = MergeErr(dst, src, func(k K, old V, new V) (V, error) { return resolver(k, old, new), nil })
+}
+
+// MergeErr adds the entries of src into dst, calling the resolver when a key is in both
+//
+// note: dst is left partially merged when the resolver fails
+func MergeErr[K comparable, V any, D ~map[K]V](dst *D, src D, resolver ErrResolver[K, V]) error {
+	if dst == nil || len(src) == 0 {
+		return nil
+	}
+	if *dst == nil {
+		*dst = make(D, len(src))
+	}
+	for k, v := range src {
+		if old, found := (*dst)[k]; found {
+			resolved, err := resolver(k, old, v)
+			if err != nil {
+				return err
+			}
+			v = resolved
+		}
+		(*dst)[k] = v
+	}
+	return nil
+}
+
+// MergeAll merges the dicts in order into a new dict, so the resolver sees the values of earlier dicts as old
+func MergeAll[K comparable, V any, D ~map[K]V](resolver Resolver[K, V], dicts ...D) D {
+	merged := newMerged(dicts)
+	for _, d := range dicts {
+		Merge(&merged, d, resolver)
+	}
+	return merged
+}
+
+// MergeAllErr merges the dicts in order into a new dict, and stops at the first failure of the resolver
+func MergeAllErr[K comparable, V any, D ~map[K]V](resolver ErrResolver[K, V], dicts ...D) (D, error) {
+	merged := newMerged(dicts)
+	for _, d := range dicts {
+		if err := MergeErr(&merged, d, resolver); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+func newMerged[K comparable, V any, D ~map[K]V](dicts []D) D {
+	size := 0
+	allNil := true
+	for _, d := range dicts {
+		size += len(d)
+		allNil = allNil && d == nil
+	}
+	if allNil {
+		return nil
+	}
+	return make(D, size)
+}
@@ -0,0 +1,176 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestMergeInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		dst      dict.Dict[int, int64]
+		src      dict.Dict[int, int64]
+		resolver dict.Resolver[int, int64]
+		wantDst  dict.Dict[int, int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			dst:      nil,
+			src:      nil,
+			resolver: nil,
+			wantDst:  nil,
+		},
+		"into-nil": {
+			dst:      nil,
+			src:      dict.Dict[int, int64]{10: 21},
+			resolver: nil,
+			wantDst:  dict.Dict[int, int64]{10: 21},
+		},
+		"from-empty": {
+			dst:      dict.Dict[int, int64]{10: 21},
+			src:      EmptyInt64Dict,
+			resolver: nil,
+			wantDst:  dict.Dict[int, int64]{10: 21},
+		},
+		"nil-resolver": {
+			dst:      dict.Dict[int, int64]{10: 1, 30: 3},
+			src:      dict.Dict[int, int64]{10: 21, 20: 12},
+			resolver: nil,
+			wantDst:  dict.Dict[int, int64]{10: 21, 20: 12, 30: 3},
+		},
+		"keep-first": {
+			dst:      dict.Dict[int, int64]{10: 1, 30: 3},
+			src:      dict.Dict[int, int64]{10: 21, 20: 12},
+			resolver: dict.KeepFirst[int, int64],
+			wantDst:  dict.Dict[int, int64]{10: 1, 20: 12, 30: 3},
+		},
+		"keep-last": {
+			dst:      dict.Dict[int, int64]{10: 1, 30: 3},
+			src:      dict.Dict[int, int64]{10: 21, 20: 12},
+			resolver: dict.KeepLast[int, int64],
+			wantDst:  dict.Dict[int, int64]{10: 21, 20: 12, 30: 3},
+		},
+		"sum": {
+			dst:      dict.Dict[int, int64]{10: 1, 30: 3},
+			src:      dict.Dict[int, int64]{10: 21, 20: 12},
+			resolver: func(_ int, old int64, new int64) int64 { return old + new },
+			wantDst:  dict.Dict[int, int64]{10: 22, 20: 12, 30: 3},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		dst := testCase.dst.Copy()
+		dst.Merge(testCase.src, testCase.resolver)
+
+		// assert
+		assertEqual(t, testCase.wantDst, dst, "wrong merged dict!")
+	})
+}
+
+func TestMergeErr(t *testing.T) {
+
+	// no conflict on equal values
+
+	dst := dict.Dict[string, int]{"a": 1, "b": 2}
+	require.NoError(t, dst.MergeErr(dict.Dict[string, int]{"a": 1, "c": 3}, dict.ErrorOnConflict[string, int]), "merge equal values")
+	assertEqual(t, dict.Dict[string, int]{"a": 1, "b": 2, "c": 3}, dst, "wrong merged dict!")
+
+	// conflict
+
+	err := dst.MergeErr(dict.Dict[string, int]{"b": 20}, dict.ErrorOnConflict[string, int])
+	require.ErrorIs(t, err, dict.ErrConflict, "wrong error!")
+	require.Contains(t, err.Error(), "on key b: 2 and 20", "wrong error message!")
+
+	// merge all
+
+	merged, err := dict.MergeAllErr(dict.ErrorOnConflict[string, int], dict.Dict[string, int]{"a": 1}, nil, dict.Dict[string, int]{"b": 2})
+	require.NoError(t, err, "merge all")
+	assertEqual(t, dict.Dict[string, int]{"a": 1, "b": 2}, merged, "wrong merged dict!")
+	_, err = dict.MergeAllErr(dict.ErrorOnConflict[string, int], dict.Dict[string, int]{"a": 1}, dict.Dict[string, int]{"a": 2})
+	require.ErrorIs(t, err, dict.ErrConflict, "wrong error!")
+}
+
+func TestMergeAll(t *testing.T) {
+
+	first := dict.Dict[string, int]{"a": 1, "b": 1}
+	second := dict.Dict[string, int]{"b": 2, "c": 2}
+	third := dict.Dict[string, int]{"c": 3}
+
+	assertEqual(t, dict.Dict[string, int]{"a": 1, "b": 2, "c": 3}, dict.MergeAll(nil, first, second, third), "keep last")
+	assertEqual(t, dict.Dict[string, int]{"a": 1, "b": 1, "c": 2}, dict.MergeAll(dict.KeepFirst[string, int], first, second, third), "keep first")
+	assertEqual(t, dict.Dict[string, int]{}, dict.MergeAll[string, int, dict.Dict[string, int]](nil, dict.Dict[string, int]{}, nil), "empty")
+	assertEqual(t, nil, dict.MergeAll[string, int, dict.Dict[string, int]](nil), "nil")
+
+	// sources unchanged
+
+	assertEqual(t, dict.Dict[string, int]{"a": 1, "b": 1}, first, "first modified")
+}
+
+func TestDeepMerge(t *testing.T) {
+
+	// layering configuration: defaults, then file, then env overrides
+
+	defaults := dict.DeepDict[string, any]{
+		"name": "service",
+		"server": dict.DeepDict[string, any]{
+			"host": "localhost",
+			"port": 8080,
+			"tls":  map[string]any{"enabled": false, "cert": "default.pem"},
+		},
+		"tags": []string{"default"},
+	}
+	file := dict.DeepDict[string, any]{
+		"server": map[string]any{
+			"port": 9090,
+			"tls":  map[string]any{"enabled": true},
+		},
+		"tags": []string{"file"},
+	}
+	env := dict.DeepDict[string, any]{
+		"server": dict.DeepDict[string, any]{"host": "0.0.0.0"},
+		"debug":  true,
+	}
+
+	// execute
+	config := dict.MergeAll(dict.DeepMerge, defaults, file, env)
+
+	// assert
+	require.Equal(t, dict.DeepDict[string, any]{
+		"name": "service",
+		"server": dict.DeepDict[string, any]{
+			"host": "0.0.0.0",
+			"port": 9090,
+			"tls":  map[string]any{"enabled": true, "cert": "default.pem"},
+		},
+		"tags":  []string{"file"},
+		"debug": true,
+	}, config, "wrong config!")
+
+	// a tree replaces a leaf, a leaf replaces a tree
+
+	require.Equal(t, dict.DeepDict[string, any]{"a": map[string]any{"b": 1}, "c": 2}, dict.MergeAll(dict.DeepMerge,
+		dict.DeepDict[string, any]{"a": 1, "c": dict.DeepDict[string, any]{"d": 1}},
+		dict.DeepDict[string, any]{"a": map[string]any{"b": 1}, "c": 2},
+	), "wrong leaf/tree merge!")
+
+	// sources unchanged
+
+	require.Equal(t, "localhost", defaults["server"].(dict.DeepDict[string, any])["host"], "defaults modified")
+	require.Equal(t, false, defaults["server"].(dict.DeepDict[string, any])["tls"].(map[string]any)["enabled"], "defaults modified")
+}
@@ -0,0 +1,239 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+// MultiDict maps each key to a list of values, duplicates included, in insertion order
+//
+// note:
+//   - keys without values are removed, so a key is present if and only if it has at least one value
+//   - Get returns a copy of the values, so the buckets can only be modified through the methods
+type MultiDict[K comparable, V comparable] map[K][]V
+
+// builder
+
+func NewMulti[K comparable, V comparable]() MultiDict[K, V] {
+	return MultiDict[K, V]{}
+}
+
+func (d *MultiDict[K, V]) With(key K, values ...V) *MultiDict[K, V] {
+	d.PutAll(key, values...)
+	return d
+}
+
+// getter
+
+func (d MultiDict[K, V]) Len() int {
+	return len(d)
+}
+
+func (d MultiDict[K, V]) Total() int {
+	total := 0
+	for _, bucket := range d {
+		total += len(bucket)
+	}
+	return total
+}
+
+func (d MultiDict[K, V]) Get(key K) []V {
+	bucket, found := d[key]
+	if !found {
+		return nil
+	}
+	return append(make([]V, 0, len(bucket)), bucket...)
+}
+
+func (d MultiDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (d MultiDict[K, V]) Values() []V {
+	values := make([]V, 0, d.Total())
+	for _, bucket := range d {
+		values = append(values, bucket...)
+	}
+	return values
+}
+
+func (d MultiDict[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, d.Total())
+	d.EachEntry(func(k K, v V) { entries = append(entries, Entry[K, V]{Key: k, Value: v}) })
+	return entries
+}
+
+// state
+
+func (d MultiDict[K, V]) IsEmpty() bool {
+	return len(d) == 0
+}
+
+func (d MultiDict[K, V]) AllEntryOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfEntry(util.BiNot(predicate))
+	return !found
+}
+
+func (d MultiDict[K, V]) AnyEntryOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfEntry(predicate)
+	return found
+}
+
+// each
+
+func (d MultiDict[K, V]) EachEntry(consumer util.BiConsumer[K, V]) {
+	d.EachEntryWhile(func(k K, v V) bool {
+		consumer(k, v)
+		return true
+	})
+}
+
+func (d MultiDict[K, V]) EachEntryWhile(consumer util.BiPredicate[K, V]) bool {
+	for k, bucket := range d {
+		for _, v := range bucket {
+			if !consumer(k, v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// find
+
+func (d MultiDict[K, V]) FindKey(key K) bool {
+	_, found := d[key]
+	return found
+}
+
+func (d MultiDict[K, V]) ContainsEntry(key K, value V) bool {
+	for _, v := range d[key] {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (d MultiDict[K, V]) FindIfEntry(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	var key K
+	var value V
+	found := !d.EachEntryWhile(func(k K, v V) bool {
+		if predicate(k, v) {
+			key, value = k, v
+			return false
+		}
+		return true
+	})
+	return key, value, found
+}
+
+// copy
+
+func (d MultiDict[K, V]) Copy() MultiDict[K, V] {
+	return d.CopyIfEntry(util.BiTrue[K, V]())
+}
+
+func (d MultiDict[K, V]) CopyIfEntry(predicate util.BiPredicate[K, V]) MultiDict[K, V] {
+	if d == nil {
+		return nil
+	}
+	copy := make(MultiDict[K, V], len(d))
+	d.EachEntry(func(k K, v V) {
+		if predicate(k, v) {
+			copy[k] = append(copy[k], v)
+		}
+	})
+	return copy
+}
+
+// modifier
+
+func (d *MultiDict[K, V]) Put(key K, value V) bool {
+	return d.PutAll(key, value)
+}
+
+func (d *MultiDict[K, V]) PutAll(key K, values ...V) bool {
+	if d == nil || len(values) == 0 {
+		return false
+	}
+	if *d == nil {
+		*d = MultiDict[K, V]{}
+	}
+	(*d)[key] = append((*d)[key], values...)
+	return true
+}
+
+// RemoveValue removes the first occurrence of the value under the key
+func (d *MultiDict[K, V]) RemoveValue(key K, value V) bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	bucket := (*d)[key]
+	for i, v := range bucket {
+		if v == value {
+			d.store(key, bucket, append(bucket[:i], bucket[i+1:]...))
+			return true
+		}
+	}
+	return false
+}
+
+func (d *MultiDict[K, V]) RemoveKey(key K) bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	if _, found := (*d)[key]; found {
+		delete(*d, key)
+		return true
+	}
+	return false
+}
+
+func (d *MultiDict[K, V]) Clear() bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	*d = MultiDict[K, V]{}
+	return true
+}
+
+func (d *MultiDict[K, V]) RemoveIfEntry(predicate util.BiPredicate[K, V]) bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	updated := false
+	for k, bucket := range *d {
+		kept := bucket[:0]
+		for _, v := range bucket {
+			if !predicate(k, v) {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) < len(bucket) {
+			d.store(k, bucket, kept)
+			updated = true
+		}
+	}
+	return updated
+}
+
+func (d *MultiDict[K, V]) KeepIfEntry(predicate util.BiPredicate[K, V]) bool {
+	return d.RemoveIfEntry(util.BiNot(predicate))
+}
+
+// internal
+
+// store replaces the bucket of the key by its compacted version, zeroing the freed tail and removing empty buckets
+func (d *MultiDict[K, V]) store(key K, bucket []V, kept []V) {
+	var empty V
+	for i := len(kept); i < len(bucket); i++ {
+		bucket[i] = empty
+	}
+	if len(kept) == 0 {
+		delete(*d, key)
+	} else {
+		(*d)[key] = kept
+	}
+}
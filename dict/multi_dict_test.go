@@ -0,0 +1,132 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+func TestMultiDict(t *testing.T) {
+
+	// builder
+
+	d := dict.NewMulti[string, int]()
+	d.With("a", 1, 2).With("b", 3)
+
+	// put
+
+	require.True(t, d.Put("a", 1), "put duplicate a-1")
+	require.True(t, d.PutAll("c", 4, 5), "put all c")
+	require.False(t, d.PutAll("d"), "put nothing")
+	require.False(t, d.FindKey("d"), "empty put creates no key")
+
+	// map[a:[1,2,1] b:[3] c:[4,5]]
+
+	// getter
+
+	require.Equal(t, 3, d.Len(), "len")
+	require.Equal(t, 6, d.Total(), "total")
+	require.Equal(t, []int{1, 2, 1}, d.Get("a"), "get a")
+	require.Nil(t, d.Get("z"), "get missing z")
+	require.ElementsMatch(t, []string{"a", "b", "c"}, d.Keys(), "keys")
+	require.ElementsMatch(t, []int{1, 2, 1, 3, 4, 5}, d.Values(), "values")
+	require.ElementsMatch(t, []dict.Entry[string, int]{
+		{Key: "a", Value: 1}, {Key: "a", Value: 2}, {Key: "a", Value: 1},
+		{Key: "b", Value: 3},
+		{Key: "c", Value: 4}, {Key: "c", Value: 5},
+	}, d.Entries(), "entries")
+
+	// get returns a copy
+
+	d.Get("a")[0] = 99
+	require.Equal(t, []int{1, 2, 1}, d.Get("a"), "get a after modifying copy")
+
+	// find
+
+	require.True(t, d.ContainsEntry("a", 2), "contains a-2")
+	require.False(t, d.ContainsEntry("b", 2), "contains b-2")
+	require.True(t, d.AllEntryOf(func(_ string, v int) bool { return v > 0 }), "all_entry_of")
+	require.True(t, d.AnyEntryOf(func(k string, v int) bool { return k == "c" && v == 5 }), "any_entry_of")
+
+	sum := 0
+	d.EachEntry(func(_ string, v int) { sum += v })
+	require.Equal(t, 16, sum, "sum - each entry")
+
+	// remove value keeps the order and cleans empty buckets
+
+	require.True(t, d.RemoveValue("a", 1), "remove a-1")
+	require.Equal(t, []int{2, 1}, d.Get("a"), "a after remove")
+	require.False(t, d.RemoveValue("a", 3), "remove missing a-3")
+	require.True(t, d.RemoveValue("b", 3), "remove b-3")
+	require.False(t, d.FindKey("b"), "empty bucket b removed")
+
+	// copy
+
+	copy := d.CopyIfEntry(func(_ string, v int) bool { return v%2 == 0 })
+	require.Equal(t, dict.MultiDict[string, int]{"a": {2}, "c": {4}}, copy, "copy if entry")
+
+	// remove if
+
+	require.True(t, d.RemoveIfEntry(func(k string, _ int) bool { return k == "c" }), "remove if entry")
+	require.False(t, d.FindKey("c"), "empty bucket c removed")
+	require.True(t, d.RemoveKey("a"), "remove key a")
+	require.False(t, d.RemoveKey("a"), "remove key a twice")
+	require.True(t, d.IsEmpty(), "is_empty")
+	require.Equal(t, dict.MultiDict[string, int]{"a": {2}, "c": {4}}, copy, "source of copy modified")
+
+	// zero value
+
+	var zero dict.MultiDict[int, int]
+	require.True(t, zero.Put(1, 1), "put to zero value")
+	require.Equal(t, 1, zero.Total(), "zero value total")
+}
+
+func TestSetMultiDict(t *testing.T) {
+
+	// builder
+
+	d := dict.NewSetMulti[string, int]()
+	d.With("a", 1, 2).With("b", 3)
+
+	// put
+
+	require.False(t, d.Put("a", 1), "put duplicate a-1")
+	require.True(t, d.PutAll("a", 1, 4), "put all a with a new value")
+	require.True(t, d.PutAll("c", 5), "put all c")
+
+	// map[a:{1,2,4} b:{3} c:{5}]
+
+	// getter
+
+	require.Equal(t, 3, d.Len(), "len")
+	require.Equal(t, 5, d.Total(), "total")
+	require.Equal(t, map[int]struct{}{1: {}, 2: {}, 4: {}}, d.Get("a"), "get a")
+	require.Nil(t, d.Get("z"), "get missing z")
+	require.ElementsMatch(t, []int{1, 2, 4, 3, 5}, d.Values(), "values")
+	require.Len(t, d.Entries(), 5, "entries")
+
+	// find
+
+	require.True(t, d.ContainsEntry("a", 4), "contains a-4")
+	require.False(t, d.ContainsEntry("z", 4), "contains z-4")
+	key, value, found := d.FindIfEntry(func(_ string, v int) bool { return v == 5 })
+	require.Equal(t, "c", key, "find key")
+	require.Equal(t, 5, value, "find value")
+	require.True(t, found, "found")
+
+	// remove cleans empty buckets
+
+	require.True(t, d.RemoveValue("b", 3), "remove b-3")
+	require.False(t, d.RemoveValue("b", 3), "remove b-3 twice")
+	require.False(t, d.FindKey("b"), "empty bucket b removed")
+
+	require.True(t, d.KeepIfEntry(func(_ string, v int) bool { return v%2 == 0 }), "keep if entry")
+	require.Equal(t, dict.SetMultiDict[string, int]{"a": {2: {}, 4: {}}}, d, "after keep if")
+
+	// clear
+
+	require.True(t, d.Clear(), "clear")
+	require.False(t, d.Clear(), "clear twice")
+}
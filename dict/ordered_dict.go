@@ -0,0 +1,382 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+// OrderedDict is a dictionary which keeps the insertion order of its keys
+//
+// note:
+//   - lookup, add and remove are O(1), thanks to a map of doubly linked entries
+//   - positional access is O(n)
+//   - the zero value is an empty dictionary ready to use
+type OrderedDict[K comparable, V any] struct {
+	entries map[K]*orderedEntry[K, V]
+	first   *orderedEntry[K, V]
+	last    *orderedEntry[K, V]
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key      K
+	value    V
+	previous *orderedEntry[K, V]
+	next     *orderedEntry[K, V]
+}
+
+// builder
+
+func NewOrdered[K comparable, V any]() *OrderedDict[K, V] {
+	return &OrderedDict[K, V]{}
+}
+
+func (d *OrderedDict[K, V]) With(key K, value V) *OrderedDict[K, V] {
+	d.Add(key, value)
+	return d
+}
+
+// getter
+
+func (d *OrderedDict[K, V]) Len() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.entries)
+}
+
+func (d *OrderedDict[K, V]) Keys() []K {
+	l := make([]K, 0, d.Len())
+	d.EachKey(func(k K, _ V) { l = append(l, k) })
+	return l
+}
+
+func (d *OrderedDict[K, V]) Values() []V {
+	l := make([]V, 0, d.Len())
+	d.EachKey(func(_ K, v V) { l = append(l, v) })
+	return l
+}
+
+func (d *OrderedDict[K, V]) At(index int) (K, V, bool) {
+	if e := d.entryAt(index); e != nil {
+		return e.key, e.value, true
+	}
+	var noKey K
+	var noValue V
+	return noKey, noValue, false
+}
+
+func (d *OrderedDict[K, V]) First() (K, V, bool) {
+	return d.At(0)
+}
+
+func (d *OrderedDict[K, V]) Last() (K, V, bool) {
+	return d.At(d.Len() - 1)
+}
+
+func (d *OrderedDict[K, V]) IndexOf(key K) int {
+	if d.Len() == 0 {
+		return -1
+	}
+	if _, found := d.entries[key]; !found {
+		return -1
+	}
+	index := 0
+	for e := d.first; e.key != key; e = e.next {
+		index++
+	}
+	return index
+}
+
+// state
+
+func (d *OrderedDict[K, V]) IsEmpty() bool {
+	return d.Len() == 0
+}
+
+func (d *OrderedDict[K, V]) AllOf(predicate util.Predicate[V]) bool {
+	_, found := d.FindIfNot(predicate)
+	return !found
+}
+
+func (d *OrderedDict[K, V]) AllKeyOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfNotKey(predicate)
+	return !found
+}
+
+func (d *OrderedDict[K, V]) AnyOf(predicate util.Predicate[V]) bool {
+	_, found := d.FindIf(predicate)
+	return found
+}
+
+func (d *OrderedDict[K, V]) AnyKeyOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfKey(predicate)
+	return found
+}
+
+func (d *OrderedDict[K, V]) NoneOf(predicate util.Predicate[V]) bool {
+	return !d.AnyOf(predicate)
+}
+
+func (d *OrderedDict[K, V]) NoKeyOf(predicate util.BiPredicate[K, V]) bool {
+	return !d.AnyKeyOf(predicate)
+}
+
+// each
+
+func (d *OrderedDict[K, V]) Each(consumer util.Consumer[V]) {
+	d.EachKey(util.ConsumeOnSecondArg[K](consumer))
+}
+
+func (d *OrderedDict[K, V]) EachKey(consumer util.BiConsumer[K, V]) {
+	if d == nil {
+		return
+	}
+	for e := d.first; e != nil; e = e.next {
+		consumer(e.key, e.value)
+	}
+}
+
+// find
+
+func (d *OrderedDict[K, V]) FindKey(key K) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	_, found := d.entries[key]
+	return found
+}
+
+func (d *OrderedDict[K, V]) FindValueFromKey(key K) (V, bool) {
+	if d.Len() > 0 {
+		if e, found := d.entries[key]; found {
+			return e.value, true
+		}
+	}
+	var noValue V
+	return noValue, false
+}
+
+func (d *OrderedDict[K, V]) Find(value V) bool {
+	_, found := d.FindKeyFromValue(value)
+	return found
+}
+
+func (d *OrderedDict[K, V]) FindKeyFromValue(value V) (K, bool) {
+	key, _, found := d.FindIfKey(func(_ K, v V) bool { return util.DeepEqual(v, value) })
+	return key, found
+}
+
+func (d *OrderedDict[K, V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	_, v, found := d.FindIfKey(util.TestOnSecondArg[K](predicate))
+	return v, found
+}
+
+func (d *OrderedDict[K, V]) FindIfKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	if d != nil {
+		for e := d.first; e != nil; e = e.next {
+			if predicate(e.key, e.value) {
+				return e.key, e.value, true
+			}
+		}
+	}
+	var noKey K
+	var noValue V
+	return noKey, noValue, false
+}
+
+func (d *OrderedDict[K, V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return d.FindIf(util.Not(predicate))
+}
+
+func (d *OrderedDict[K, V]) FindIfNotKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	return d.FindIfKey(util.BiNot(predicate))
+}
+
+// copy
+
+func (d *OrderedDict[K, V]) Copy() *OrderedDict[K, V] {
+	return d.CopyIfKey(util.BiTrue[K, V]())
+}
+
+func (d *OrderedDict[K, V]) CopyIf(predicate util.Predicate[V]) *OrderedDict[K, V] {
+	return d.CopyIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *OrderedDict[K, V]) CopyIfKey(predicate util.BiPredicate[K, V]) *OrderedDict[K, V] {
+	if d == nil {
+		return nil
+	}
+	copy := NewOrdered[K, V]()
+	d.EachKey(func(k K, v V) {
+		if predicate(k, v) {
+			copy.Add(k, v)
+		}
+	})
+	return copy
+}
+
+func (d *OrderedDict[K, V]) CopyIfNot(predicate util.Predicate[V]) *OrderedDict[K, V] {
+	return d.CopyIf(util.Not(predicate))
+}
+
+func (d *OrderedDict[K, V]) CopyIfNotKey(predicate util.BiPredicate[K, V]) *OrderedDict[K, V] {
+	return d.CopyIfKey(util.BiNot(predicate))
+}
+
+// modifier
+
+func (d *OrderedDict[K, V]) Add(key K, value V) bool {
+	if d == nil {
+		return false
+	}
+	if e, found := d.entries[key]; found {
+		// note: overriding a value keeps the original position of the key
+		e.value = value
+		return true
+	}
+	if d.entries == nil {
+		d.entries = map[K]*orderedEntry[K, V]{}
+	}
+	e := &orderedEntry[K, V]{key: key, value: value}
+	d.entries[key] = e
+	d.linkBack(e)
+	return false
+}
+
+func (d *OrderedDict[K, V]) Remove(key K) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	e, found := d.entries[key]
+	if !found {
+		return false
+	}
+	delete(d.entries, key)
+	d.unlink(e)
+	return true
+}
+
+func (d *OrderedDict[K, V]) Clear() bool {
+	if d.Len() == 0 {
+		return false
+	}
+	d.entries = map[K]*orderedEntry[K, V]{}
+	d.first = nil
+	d.last = nil
+	return true
+}
+
+func (d *OrderedDict[K, V]) RemoveIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *OrderedDict[K, V]) RemoveIfKey(predicate util.BiPredicate[K, V]) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	updated := false
+	for e := d.first; e != nil; {
+		next := e.next
+		if predicate(e.key, e.value) {
+			delete(d.entries, e.key)
+			d.unlink(e)
+			updated = true
+		}
+		e = next
+	}
+	return updated
+}
+
+func (d *OrderedDict[K, V]) KeepIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIf(util.Not(predicate))
+}
+
+func (d *OrderedDict[K, V]) KeepIfKey(predicate util.BiPredicate[K, V]) bool {
+	return d.RemoveIfKey(util.BiNot(predicate))
+}
+
+func (d *OrderedDict[K, V]) MoveToFront(key K) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	e, found := d.entries[key]
+	if !found {
+		return false
+	}
+	if e != d.first {
+		d.unlink(e)
+		d.linkFront(e)
+	}
+	return true
+}
+
+func (d *OrderedDict[K, V]) MoveToBack(key K) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	e, found := d.entries[key]
+	if !found {
+		return false
+	}
+	if e != d.last {
+		d.unlink(e)
+		d.linkBack(e)
+	}
+	return true
+}
+
+// internal
+
+func (d *OrderedDict[K, V]) entryAt(index int) *orderedEntry[K, V] {
+	size := d.Len()
+	if index < 0 || index >= size {
+		return nil
+	}
+	// walk from the nearest end
+	if index < size/2 {
+		e := d.first
+		for i := 0; i < index; i++ {
+			e = e.next
+		}
+		return e
+	}
+	e := d.last
+	for i := size - 1; i > index; i-- {
+		e = e.previous
+	}
+	return e
+}
+
+func (d *OrderedDict[K, V]) linkFront(e *orderedEntry[K, V]) {
+	e.previous = nil
+	e.next = d.first
+	if d.first != nil {
+		d.first.previous = e
+	} else {
+		d.last = e
+	}
+	d.first = e
+}
+
+func (d *OrderedDict[K, V]) linkBack(e *orderedEntry[K, V]) {
+	e.next = nil
+	e.previous = d.last
+	if d.last != nil {
+		d.last.next = e
+	} else {
+		d.first = e
+	}
+	d.last = e
+}
+
+func (d *OrderedDict[K, V]) unlink(e *orderedEntry[K, V]) {
+	if e.previous != nil {
+		e.previous.next = e.next
+	} else {
+		d.first = e.next
+	}
+	if e.next != nil {
+		e.next.previous = e.previous
+	} else {
+		d.last = e.previous
+	}
+	e.previous = nil
+	e.next = nil
+}
@@ -0,0 +1,126 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+func TestOrderedDict(t *testing.T) {
+
+	// builder
+
+	d := dict.NewOrdered[int, Item]().
+		With(30, Item{Value: 21}).
+		With(10, Item{Value: 22}).
+		With(20, Item{Value: 23})
+
+	require.Equal(t, []int{30, 10, 20}, d.Keys(), "insertion order")
+
+	// add
+
+	require.False(t, d.Add(40, Item{Value: 15}), "add 40-15")
+	require.True(t, d.Add(40, Item{Value: 17}), "override 40-17")
+	require.Equal(t, []int{30, 10, 20, 40}, d.Keys(), "override keeps position")
+
+	// remove
+
+	require.True(t, d.Remove(10), "remove 10")
+	require.False(t, d.Remove(10), "remove 10 twice")
+
+	// ordered[30:21,20:23,40:17]
+
+	require.Equal(t, []int{30, 20, 40}, d.Keys(), "keys after remove")
+	require.Equal(t, []Item{{Value: 21}, {Value: 23}, {Value: 17}}, d.Values(), "values after remove")
+
+	// position
+
+	key, item, found := d.At(1)
+	require.Equal(t, 20, key, "key at 1")
+	require.Equal(t, Item{Value: 23}, item, "item at 1")
+	require.True(t, found, "found at 1")
+	_, _, found = d.At(3)
+	require.False(t, found, "found at 3")
+	key, _, _ = d.First()
+	require.Equal(t, 30, key, "first key")
+	key, _, _ = d.Last()
+	require.Equal(t, 40, key, "last key")
+	require.Equal(t, 2, d.IndexOf(40), "index of 40")
+	require.Equal(t, -1, d.IndexOf(10), "index of 10")
+
+	// move
+
+	require.True(t, d.MoveToFront(40), "move 40 to front")
+	require.Equal(t, []int{40, 30, 20}, d.Keys(), "keys after move to front")
+	require.True(t, d.MoveToBack(30), "move 30 to back")
+	require.Equal(t, []int{40, 20, 30}, d.Keys(), "keys after move to back")
+	require.False(t, d.MoveToBack(10), "move 10 to back")
+
+	// predicate
+
+	require.True(t, d.AllOf(func(i Item) bool { return i.Value < 30 }), "all_of")
+	require.False(t, d.AllOf(func(i Item) bool { return i.Value < 20 }), "all_of")
+	require.True(t, d.NoneOf(func(i Item) bool { return i.Value < 10 }), "none_of")
+	require.False(t, d.AnyOf(func(i Item) bool { return i.Value < 10 }), "any_of")
+
+	// find
+
+	require.True(t, d.FindKey(30), "find 30")
+	require.False(t, d.FindKey(50), "find 50")
+	require.True(t, d.Find(Item{Value: 23}), "find 23")
+
+	key, found = d.FindKeyFromValue(Item{Value: 21})
+	require.Equal(t, 30, key, "key of 21")
+	require.True(t, found, "found 21")
+
+	item, found = d.FindIf(func(i Item) bool { return i.Value%2 == 1 })
+	require.Equal(t, Item{Value: 17}, item, "first odd item in order")
+	require.True(t, found, "found odd")
+
+	// range
+
+	var keys []int
+	d.EachKey(func(k int, _ Item) { keys = append(keys, k) })
+	require.Equal(t, []int{40, 20, 30}, keys, "each key in order")
+
+	// remove if
+
+	copy := d.Copy()
+	updated := copy.RemoveIf(func(item Item) bool { return item.Value == 23 })
+	require.True(t, updated, "wrong updated!")
+	require.Equal(t, []int{40, 30}, copy.Keys(), "wrong keys after remove if")
+
+	// keep if
+
+	copy = d.Copy()
+	updated = copy.KeepIfKey(func(k int, _ Item) bool { return k >= 30 })
+	require.True(t, updated, "wrong updated!")
+	require.Equal(t, []int{40, 30}, copy.Keys(), "wrong keys after keep if")
+
+	// copy if
+
+	copy = d.CopyIf(func(item Item) bool { return item.Value > 20 })
+	require.Equal(t, []int{20, 30}, copy.Keys(), "wrong keys after copy if")
+
+	// check source of copy
+
+	require.Equal(t, []int{40, 20, 30}, d.Keys(), "source of copy has been modified")
+
+	// clear
+
+	require.Equal(t, 3, d.Len(), "len before clear")
+	require.False(t, d.IsEmpty(), "is_empty before clear")
+	require.True(t, d.Clear(), "wrong updated!")
+	require.Equal(t, 0, d.Len(), "len after clear")
+	require.True(t, d.IsEmpty(), "is_empty after clear")
+	require.False(t, d.Clear(), "wrong updated!")
+
+	// zero value
+
+	var zero dict.OrderedDict[string, int]
+	require.True(t, zero.IsEmpty(), "zero is empty")
+	require.False(t, zero.Add("a", 1), "add to zero")
+	require.Equal(t, []string{"a"}, zero.Keys(), "zero keys")
+}
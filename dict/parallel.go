@@ -0,0 +1,24 @@
+package dict
+
+import (
+	"context"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// note: workers <= 0 means runtime.GOMAXPROCS(0) workers, see util.ParallelFor for cancellation and panics
+
+func ParallelEach[K comparable, V any, D ~map[K]V](ctx context.Context, d D, workers int, consumer util.Consumer[V]) error {
+	return ParallelEachKey(ctx, d, workers, util.ConsumeOnSecondArg[K](consumer))
+}
+
+func ParallelEachKey[K comparable, V any, D ~map[K]V](ctx context.Context, d D, workers int, consumer util.BiConsumer[K, V]) error {
+	// note: entries are snapshotted first, since a map cannot be indexed
+	keys := make([]K, 0, len(d))
+	values := make([]V, 0, len(d))
+	for k, v := range d {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return util.ParallelFor(ctx, len(keys), workers, func(i int) { consumer(keys[i], values[i]) })
+}
@@ -0,0 +1,58 @@
+package dict_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestParallelEachKey(t *testing.T) {
+
+	d := dict.Dict[int, int]{}
+	for i := 0; i < 1000; i++ {
+		d[i] = i * 2
+	}
+
+	// every entry is consumed once
+
+	var mutex sync.Mutex
+	got := dict.Dict[int, int]{}
+	err := dict.ParallelEachKey(context.Background(), d, 8, func(k int, v int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		got[k] = v
+	})
+	require.NoError(t, err, "wrong error!")
+	assertEqual(t, d, got, "wrong entries!")
+
+	// nil
+
+	err = dict.ParallelEach(context.Background(), dict.Dict[int, int](nil), 8, func(int) { panic("called on nil") })
+	require.NoError(t, err, "wrong error!")
+
+	// cancelled
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = dict.ParallelEach(ctx, d, 8, func(int) {})
+	require.ErrorIs(t, err, context.Canceled, "wrong error!")
+
+	// panic
+
+	func() {
+		defer func() {
+			_, ok := recover().(*util.PanicError)
+			require.True(t, ok, "panic not propagated")
+		}()
+		_ = dict.ParallelEachKey(context.Background(), d, 8, func(k int, _ int) {
+			if k == 42 {
+				panic("boom")
+			}
+		})
+	}()
+}
@@ -0,0 +1,23 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func Partition[K comparable, V any, D ~map[K]V](d D, predicate util.Predicate[V]) (D, D) {
+	return PartitionKey(d, util.TestOnSecondArg[K](predicate))
+}
+
+func PartitionKey[K comparable, V any, D ~map[K]V](d D, predicate util.BiPredicate[K, V]) (D, D) {
+	if d == nil {
+		return nil, nil
+	}
+	matching := D{}
+	others := D{}
+	for k, v := range d {
+		if predicate(k, v) {
+			matching[k] = v
+		} else {
+			others[k] = v
+		}
+	}
+	return matching, others
+}
@@ -0,0 +1,70 @@
+package dict_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestPartitionInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items        dict.Dict[int, int64]
+		predicate    util.Predicate[int64]
+		wantMatching dict.Dict[int, int64]
+		wantOthers   dict.Dict[int, int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:        nil,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: nil,
+			wantOthers:   nil,
+		},
+		"empty": {
+			items:        EmptyInt64Dict,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: dict.Dict[int, int64]{},
+			wantOthers:   dict.Dict[int, int64]{},
+		},
+		"even": {
+			items:        DefaultInt64Dict,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: dict.Dict[int, int64]{20: 12, 30: 34, 50: 52},
+			wantOthers:   dict.Dict[int, int64]{10: 21, 40: 87},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotMatching, gotOthers := testCase.items.Partition(testCase.predicate)
+
+		// assert
+		assertEqual(t, testCase.wantMatching, gotMatching, "wrong matching items!")
+		assertEqual(t, testCase.wantOthers, gotOthers, "wrong other items!")
+	})
+}
+
+func TestPartitionKeyStruct(t *testing.T) {
+
+	// execute
+	gotMatching, gotOthers := dict.PartitionKey(DefaultItemDict, func(k int, _ Item) bool { return k >= 40 })
+
+	// assert
+	assertEqual(t, dict.Dict[int, Item]{40: {Value: 87}, 50: {Value: 52}}, gotMatching, "wrong matching items!")
+	assertEqual(t, dict.Dict[int, Item]{10: {Value: 21}, 20: {Value: 12}, 30: {Value: 34}}, gotOthers, "wrong other items!")
+}
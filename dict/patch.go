@@ -0,0 +1,124 @@
+package dict
+
+import (
+	"fmt"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// Patch turns a dict equal to its base into its target, and back
+//
+// note:
+//   - Apply and Revert check the whole patch against the dict before modifying it,
+//     and fail with ErrConflict if the dict does not match, leaving it unchanged
+//   - only the patched keys are checked, the other keys are left as is
+type Patch[K comparable, V any, D ~map[K]V] struct {
+	delta Delta[K, V]
+	equal util.BiPredicate[V, V]
+}
+
+// builder
+
+func NewPatch[K comparable, V comparable, D ~map[K]V](base D, target D) *Patch[K, V, D] {
+	return NewPatchFn(base, target, util.Equal[V])
+}
+
+func NewDeepPatch[K comparable, V any, D ~map[K]V](base D, target D) *Patch[K, V, D] {
+	return NewPatchFn(base, target, util.DeepEqual[V])
+}
+
+func NewPatchFn[K comparable, V any, D ~map[K]V](base D, target D, equal util.BiPredicate[V, V]) *Patch[K, V, D] {
+	return NewPatchFromDeltaFn[K, V, D](DiffFn(base, target, equal), equal)
+}
+
+// NewPatchFromDelta rebuilds a patch from its delta, for instance received from another service
+func NewPatchFromDelta[K comparable, V comparable, D ~map[K]V](delta Delta[K, V]) *Patch[K, V, D] {
+	return NewPatchFromDeltaFn[K, V, D](delta, util.Equal[V])
+}
+
+func NewDeepPatchFromDelta[K comparable, V any, D ~map[K]V](delta Delta[K, V]) *Patch[K, V, D] {
+	return NewPatchFromDeltaFn[K, V, D](delta, util.DeepEqual[V])
+}
+
+func NewPatchFromDeltaFn[K comparable, V any, D ~map[K]V](delta Delta[K, V], equal util.BiPredicate[V, V]) *Patch[K, V, D] {
+	return &Patch[K, V, D]{delta: delta, equal: equal}
+}
+
+// getter
+
+func (p *Patch[K, V, D]) Delta() Delta[K, V] {
+	return p.delta
+}
+
+func (p *Patch[K, V, D]) IsEmpty() bool {
+	return p.delta.IsEmpty()
+}
+
+func (p *Patch[K, V, D]) String() string {
+	return p.delta.String()
+}
+
+// Inverse returns the patch from the target back to the base
+func (p *Patch[K, V, D]) Inverse() *Patch[K, V, D] {
+	inverse := &Patch[K, V, D]{
+		delta: Delta[K, V]{
+			Added:   p.delta.Removed,
+			Removed: p.delta.Added,
+			Changed: make(DeepDict[K, Change[V]], len(p.delta.Changed)),
+		},
+		equal: p.equal,
+	}
+	for k, c := range p.delta.Changed {
+		inverse.delta.Changed[k] = Change[V]{Old: c.New, New: c.Old}
+	}
+	return inverse
+}
+
+// modifier
+
+func (p *Patch[K, V, D]) Apply(target *D) error {
+	if target == nil {
+		return fmt.Errorf("%w: nil target", ErrConflict)
+	}
+	if err := p.check(*target); err != nil {
+		return err
+	}
+	if *target == nil && len(p.delta.Added) > 0 {
+		*target = make(D, len(p.delta.Added))
+	}
+	for k := range p.delta.Removed {
+		delete(*target, k)
+	}
+	for k, c := range p.delta.Changed {
+		(*target)[k] = c.New
+	}
+	for k, v := range p.delta.Added {
+		(*target)[k] = v
+	}
+	return nil
+}
+
+func (p *Patch[K, V, D]) Revert(target *D) error {
+	return p.Inverse().Apply(target)
+}
+
+// internal
+
+func (p *Patch[K, V, D]) check(target D) error {
+	for k, v := range p.delta.Removed {
+		if current, found := target[k]; !found || !p.equal(current, v) {
+			return fmt.Errorf("%w: key %v is not %v", ErrConflict, k, v)
+		}
+	}
+	for k, c := range p.delta.Changed {
+		if current, found := target[k]; !found || !p.equal(current, c.Old) {
+			return fmt.Errorf("%w: key %v is not %v", ErrConflict, k, c.Old)
+		}
+	}
+	for k := range p.delta.Added {
+		if _, found := target[k]; found {
+			return fmt.Errorf("%w: key %v already exists", ErrConflict, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package dict_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+func TestPatch(t *testing.T) {
+
+	other := dict.Dict[int, int64]{10: 21, 20: 13, 40: 87, 50: 52, 60: 69}
+	patch := dict.NewPatch(DefaultInt64Dict, other)
+	require.False(t, patch.IsEmpty(), "is_empty")
+	require.Equal(t, "~ 20: 12 -> 13\n- 30: 34\n+ 60: 69\n", patch.String(), "wrong text!")
+
+	// apply
+
+	target := DefaultInt64Dict.Copy()
+	require.NoError(t, patch.Apply(&target), "apply")
+	assertEqual(t, other, target, "wrong applied dict!")
+
+	// apply twice conflicts and leaves the dict unchanged
+
+	require.ErrorIs(t, patch.Apply(&target), dict.ErrConflict, "apply twice")
+	assertEqual(t, other, target, "dict modified by a conflicting apply!")
+
+	// revert
+
+	require.NoError(t, patch.Revert(&target), "revert")
+	assertEqual(t, DefaultInt64Dict, target, "wrong reverted dict!")
+	require.ErrorIs(t, patch.Revert(&target), dict.ErrConflict, "revert twice")
+
+	// other keys are not checked
+
+	target = DefaultInt64Dict.Copy()
+	target[70] = 1
+	require.NoError(t, patch.Apply(&target), "apply with an extra key")
+	require.Equal(t, int64(1), target[70], "extra key modified")
+
+	// conflict on a changed value
+
+	target = DefaultInt64Dict.Copy()
+	target[20] = 99
+	require.ErrorIs(t, patch.Apply(&target), dict.ErrConflict, "apply on a changed value")
+
+	// nil
+
+	var empty dict.Dict[int, int64]
+	require.NoError(t, dict.NewPatch(nil, DefaultInt64Dict).Apply(&empty), "apply to nil")
+	assertEqual(t, DefaultInt64Dict, empty, "wrong dict applied to nil!")
+	require.ErrorIs(t, patch.Apply(nil), dict.ErrConflict, "apply to nil pointer")
+}
+
+func TestDeepPatch(t *testing.T) {
+
+	base := dict.Dict[int, *Item]{10: {Value: 21}, 20: {Value: 12}}
+	target := dict.Dict[int, *Item]{10: {Value: 21}, 20: {Value: 13}}
+	patch := dict.NewDeepPatch(base, target)
+
+	// an equal copy of the base can be patched
+
+	copy := dict.Dict[int, *Item]{10: {Value: 21}, 20: {Value: 12}}
+	require.NoError(t, patch.Apply(&copy), "apply")
+	require.Equal(t, int64(13), copy[20].Value, "wrong applied value!")
+	require.NoError(t, patch.Inverse().Apply(&copy), "apply inverse")
+	require.Equal(t, int64(12), copy[20].Value, "wrong reverted value!")
+}
+
+func TestPatchFromDelta(t *testing.T) {
+
+	// the delta is shipped as json and the patch rebuilt on the other side
+
+	sent := dict.NewDeepPatch(DefaultItemPointerDict, OtherItemPointerDict)
+	data, err := json.Marshal(sent.Delta())
+	require.NoError(t, err, "marshal delta")
+
+	var delta dict.Delta[int, *Item]
+	require.NoError(t, json.Unmarshal(data, &delta), "unmarshal delta")
+	received := dict.NewDeepPatchFromDelta[int, *Item, dict.Dict[int, *Item]](delta)
+	require.Equal(t, sent.String(), received.String(), "wrong received patch!")
+
+	// apply and revert
+
+	target := DefaultItemPointerDict.Copy()
+	require.NoError(t, received.Apply(&target), "apply")
+	assertDeepEqual(t, OtherItemPointerDict, target, "wrong applied dict!")
+	require.NoError(t, received.Revert(&target), "revert")
+	assertDeepEqual(t, DefaultItemPointerDict, target, "wrong reverted dict!")
+
+	// comparable values
+
+	other := dict.Dict[int, int64]{10: 21, 20: 13}
+	target64 := DefaultInt64Dict.Copy()
+	require.NoError(t, dict.NewPatchFromDelta[int, int64, dict.Dict[int, int64]](dict.Diff(DefaultInt64Dict, other)).Apply(&target64), "apply comparable")
+	assertEqual(t, other, target64, "wrong applied comparable dict!")
+}
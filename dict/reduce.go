@@ -0,0 +1,16 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+func Reduce[K comparable, V any, A any, D ~map[K]V](d D, initial A, reducer util.BiTransformer[A, V, A]) A {
+	return ReduceKey(d, initial, func(acc A, _ K, v V) A { return reducer(acc, v) })
+}
+
+func ReduceKey[K comparable, V any, A any, D ~map[K]V](d D, initial A, reducer util.TriTransformer[A, K, V, A]) A {
+	// note: the map iteration order is random, so the reducer should be commutative
+	acc := initial
+	for k, v := range d {
+		acc = reducer(acc, k, v)
+	}
+	return acc
+}
@@ -0,0 +1,95 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestReduceInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items   dict.Dict[int, int64]
+		initial int64
+		wantSum int64
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:   nil,
+			initial: 5,
+			wantSum: 5,
+		},
+		"empty": {
+			items:   EmptyInt64Dict,
+			initial: 5,
+			wantSum: 5,
+		},
+		"all": {
+			items:   DefaultInt64Dict,
+			initial: 5,
+			wantSum: 5 + 21 + 12 + 34 + 87 + 52,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotSum := dict.Reduce(testCase.items, testCase.initial, func(acc int64, item int64) int64 { return acc + item })
+
+		// assert
+		require.Equalf(t, testCase.wantSum, gotSum, "wrong sum!")
+	})
+}
+
+func TestReduceKeyStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items   dict.Dict[int, Item]
+		wantSum int64
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:   nil,
+			wantSum: 0,
+		},
+		"empty": {
+			items:   EmptyItemDict,
+			wantSum: 0,
+		},
+		"all": {
+			items:   DefaultItemDict,
+			wantSum: 10 + 21 + 20 + 12 + 30 + 34 + 40 + 87 + 50 + 52,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotSum := dict.ReduceKey(testCase.items, int64(0), func(acc int64, k int, item Item) int64 { return acc + int64(k) + item.Value })
+
+		// assert
+		require.Equalf(t, testCase.wantSum, gotSum, "wrong sum!")
+	})
+}
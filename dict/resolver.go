@@ -0,0 +1,68 @@
+package dict
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// Resolver computes the value to keep when two values collide on the same key
+type Resolver[K any, V any] func(key K, old V, new V) V
+
+// ErrResolver computes the value to keep when two values collide on the same key, or fails
+type ErrResolver[K any, V any] func(key K, old V, new V) (V, error)
+
+// ErrConflict is returned by ErrorOnConflict when two different values collide on the same key,
+// and by the patches of dict, set and list when the target does not match the base
+var ErrConflict = errors.New("conflicting values")
+
+// KeepFirst is a Resolver keeping the old value
+func KeepFirst[K any, V any](_ K, old V, _ V) V {
+	return old
+}
+
+// KeepLast is a Resolver keeping the new value
+func KeepLast[K any, V any](_ K, _ V, new V) V {
+	return new
+}
+
+// ErrorOnConflict is an ErrResolver failing with ErrConflict unless both values are deeply equal
+func ErrorOnConflict[K any, V any](key K, old V, new V) (V, error) {
+	if !util.DeepEqual(old, new) {
+		return old, fmt.Errorf("%w on key %v: %v and %v", ErrConflict, key, old, new)
+	}
+	return old, nil
+}
+
+// DeepMerge is a Resolver merging trees of DeepDict[string, any] or map[string]any recursively,
+// the new value wins when one of the values is not a tree
+//
+// note: the old trees are not modified, but the subtrees without conflict are shared with the result
+func DeepMerge(key string, old any, new any) any {
+	newTree, isTree := asTree(new)
+	if !isTree {
+		return new
+	}
+	switch oldTree := old.(type) {
+	case DeepDict[string, any]:
+		merged := Copy(oldTree)
+		Merge(&merged, newTree, DeepMerge)
+		return merged
+	case map[string]any:
+		merged := Copy(oldTree)
+		Merge(&merged, newTree, DeepMerge)
+		return merged
+	}
+	return new
+}
+
+func asTree(value any) (map[string]any, bool) {
+	switch tree := value.(type) {
+	case DeepDict[string, any]:
+		return tree, true
+	case map[string]any:
+		return tree, true
+	}
+	return nil, false
+}
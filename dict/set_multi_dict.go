@@ -0,0 +1,229 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+// SetMultiDict maps each key to a set of distinct values
+//
+// note:
+//   - keys without values are removed, so a key is present if and only if it has at least one value
+//   - Get returns a copy of the values, so the buckets can only be modified through the methods
+type SetMultiDict[K comparable, V comparable] map[K]map[V]struct{}
+
+// builder
+
+func NewSetMulti[K comparable, V comparable]() SetMultiDict[K, V] {
+	return SetMultiDict[K, V]{}
+}
+
+func (d *SetMultiDict[K, V]) With(key K, values ...V) *SetMultiDict[K, V] {
+	d.PutAll(key, values...)
+	return d
+}
+
+// getter
+
+func (d SetMultiDict[K, V]) Len() int {
+	return len(d)
+}
+
+func (d SetMultiDict[K, V]) Total() int {
+	total := 0
+	for _, bucket := range d {
+		total += len(bucket)
+	}
+	return total
+}
+
+func (d SetMultiDict[K, V]) Get(key K) map[V]struct{} {
+	bucket, found := d[key]
+	if !found {
+		return nil
+	}
+	return Copy(bucket)
+}
+
+func (d SetMultiDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (d SetMultiDict[K, V]) Values() []V {
+	values := make([]V, 0, d.Total())
+	for _, bucket := range d {
+		for v := range bucket {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func (d SetMultiDict[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, d.Total())
+	d.EachEntry(func(k K, v V) { entries = append(entries, Entry[K, V]{Key: k, Value: v}) })
+	return entries
+}
+
+// state
+
+func (d SetMultiDict[K, V]) IsEmpty() bool {
+	return len(d) == 0
+}
+
+func (d SetMultiDict[K, V]) AllEntryOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfEntry(util.BiNot(predicate))
+	return !found
+}
+
+func (d SetMultiDict[K, V]) AnyEntryOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfEntry(predicate)
+	return found
+}
+
+// each
+
+func (d SetMultiDict[K, V]) EachEntry(consumer util.BiConsumer[K, V]) {
+	d.EachEntryWhile(func(k K, v V) bool {
+		consumer(k, v)
+		return true
+	})
+}
+
+func (d SetMultiDict[K, V]) EachEntryWhile(consumer util.BiPredicate[K, V]) bool {
+	for k, bucket := range d {
+		for v := range bucket {
+			if !consumer(k, v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// find
+
+func (d SetMultiDict[K, V]) FindKey(key K) bool {
+	_, found := d[key]
+	return found
+}
+
+func (d SetMultiDict[K, V]) ContainsEntry(key K, value V) bool {
+	_, found := d[key][value]
+	return found
+}
+
+func (d SetMultiDict[K, V]) FindIfEntry(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	var key K
+	var value V
+	found := !d.EachEntryWhile(func(k K, v V) bool {
+		if predicate(k, v) {
+			key, value = k, v
+			return false
+		}
+		return true
+	})
+	return key, value, found
+}
+
+// copy
+
+func (d SetMultiDict[K, V]) Copy() SetMultiDict[K, V] {
+	return d.CopyIfEntry(util.BiTrue[K, V]())
+}
+
+func (d SetMultiDict[K, V]) CopyIfEntry(predicate util.BiPredicate[K, V]) SetMultiDict[K, V] {
+	if d == nil {
+		return nil
+	}
+	copy := make(SetMultiDict[K, V], len(d))
+	d.EachEntry(func(k K, v V) {
+		if predicate(k, v) {
+			copy.PutAll(k, v)
+		}
+	})
+	return copy
+}
+
+// modifier
+
+func (d *SetMultiDict[K, V]) Put(key K, value V) bool {
+	return d.PutAll(key, value)
+}
+
+func (d *SetMultiDict[K, V]) PutAll(key K, values ...V) bool {
+	if d == nil || len(values) == 0 {
+		return false
+	}
+	if *d == nil {
+		*d = SetMultiDict[K, V]{}
+	}
+	bucket, found := (*d)[key]
+	if !found {
+		bucket = make(map[V]struct{}, len(values))
+		(*d)[key] = bucket
+	}
+	size := len(bucket)
+	for _, v := range values {
+		bucket[v] = struct{}{}
+	}
+	return len(bucket) > size
+}
+
+func (d *SetMultiDict[K, V]) RemoveValue(key K, value V) bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	bucket := (*d)[key]
+	if _, found := bucket[value]; !found {
+		return false
+	}
+	delete(bucket, value)
+	if len(bucket) == 0 {
+		delete(*d, key)
+	}
+	return true
+}
+
+func (d *SetMultiDict[K, V]) RemoveKey(key K) bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	if _, found := (*d)[key]; found {
+		delete(*d, key)
+		return true
+	}
+	return false
+}
+
+func (d *SetMultiDict[K, V]) Clear() bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	*d = SetMultiDict[K, V]{}
+	return true
+}
+
+func (d *SetMultiDict[K, V]) RemoveIfEntry(predicate util.BiPredicate[K, V]) bool {
+	if d == nil || len(*d) == 0 {
+		return false
+	}
+	updated := false
+	for k, bucket := range *d {
+		for v := range bucket {
+			if predicate(k, v) {
+				delete(bucket, v)
+				updated = true
+			}
+		}
+		if len(bucket) == 0 {
+			delete(*d, k)
+		}
+	}
+	return updated
+}
+
+func (d *SetMultiDict[K, V]) KeepIfEntry(predicate util.BiPredicate[K, V]) bool {
+	return d.RemoveIfEntry(util.BiNot(predicate))
+}
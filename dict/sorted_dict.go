@@ -0,0 +1,380 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+// SortedDict is a dictionary which keeps its keys sorted according to a comparator
+//
+// note:
+//   - it is backed by an AVL tree, so lookup, add and remove are O(log n)
+//   - rank and select are O(log n) too
+//   - the zero value is not usable, as it has no comparator: use NewSorted or NewSortedOrdered
+type SortedDict[K any, V any] struct {
+	comparator util.Comparator[K]
+	root       *sortedNode[K, V]
+}
+
+// builder
+
+// NewSorted panics if the comparator is nil
+func NewSorted[K any, V any](comparator util.Comparator[K]) *SortedDict[K, V] {
+	if comparator == nil {
+		panic("dict: nil comparator for sorted dict")
+	}
+	return &SortedDict[K, V]{comparator: comparator}
+}
+
+func NewSortedOrdered[K util.Ordered, V any]() *SortedDict[K, V] {
+	return NewSorted[K, V](util.Compare[K])
+}
+
+func (d *SortedDict[K, V]) With(key K, value V) *SortedDict[K, V] {
+	d.Add(key, value)
+	return d
+}
+
+// getter
+
+func (d *SortedDict[K, V]) Len() int {
+	if d == nil {
+		return 0
+	}
+	return nodeSize(d.root)
+}
+
+func (d *SortedDict[K, V]) Keys() []K {
+	l := make([]K, 0, d.Len())
+	d.EachKey(func(k K, _ V) { l = append(l, k) })
+	return l
+}
+
+func (d *SortedDict[K, V]) Values() []V {
+	l := make([]V, 0, d.Len())
+	d.EachKey(func(_ K, v V) { l = append(l, v) })
+	return l
+}
+
+func (d *SortedDict[K, V]) First() (K, V, bool) {
+	return entryOf(minNode(d.getRoot()))
+}
+
+func (d *SortedDict[K, V]) Last() (K, V, bool) {
+	return entryOf(maxNode(d.getRoot()))
+}
+
+// Floor returns the greatest entry whose key is lower than or equal to the given key
+func (d *SortedDict[K, V]) Floor(key K) (K, V, bool) {
+	return d.search(key, true, true)
+}
+
+// Lower returns the greatest entry whose key is strictly lower than the given key
+func (d *SortedDict[K, V]) Lower(key K) (K, V, bool) {
+	return d.search(key, true, false)
+}
+
+// Ceiling returns the least entry whose key is greater than or equal to the given key
+func (d *SortedDict[K, V]) Ceiling(key K) (K, V, bool) {
+	return d.search(key, false, true)
+}
+
+// Higher returns the least entry whose key is strictly greater than the given key
+func (d *SortedDict[K, V]) Higher(key K) (K, V, bool) {
+	return d.search(key, false, false)
+}
+
+// Rank returns the number of keys strictly lower than the given key
+func (d *SortedDict[K, V]) Rank(key K) int {
+	rank := 0
+	for n := d.getRoot(); n != nil; {
+		if d.comparator(key, n.key) <= 0 {
+			n = n.left
+		} else {
+			rank += nodeSize(n.left) + 1
+			n = n.right
+		}
+	}
+	return rank
+}
+
+// Select returns the entry at the given index in key order
+func (d *SortedDict[K, V]) Select(index int) (K, V, bool) {
+	for n := d.getRoot(); n != nil; {
+		size := nodeSize(n.left)
+		switch {
+		case index < size:
+			n = n.left
+		case index == size:
+			return n.key, n.value, true
+		default:
+			index -= size + 1
+			n = n.right
+		}
+	}
+	var noKey K
+	var noValue V
+	return noKey, noValue, false
+}
+
+// state
+
+func (d *SortedDict[K, V]) IsEmpty() bool {
+	return d.Len() == 0
+}
+
+func (d *SortedDict[K, V]) AllOf(predicate util.Predicate[V]) bool {
+	_, found := d.FindIfNot(predicate)
+	return !found
+}
+
+func (d *SortedDict[K, V]) AllKeyOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfNotKey(predicate)
+	return !found
+}
+
+func (d *SortedDict[K, V]) AnyOf(predicate util.Predicate[V]) bool {
+	_, found := d.FindIf(predicate)
+	return found
+}
+
+func (d *SortedDict[K, V]) AnyKeyOf(predicate util.BiPredicate[K, V]) bool {
+	_, _, found := d.FindIfKey(predicate)
+	return found
+}
+
+func (d *SortedDict[K, V]) NoneOf(predicate util.Predicate[V]) bool {
+	return !d.AnyOf(predicate)
+}
+
+func (d *SortedDict[K, V]) NoKeyOf(predicate util.BiPredicate[K, V]) bool {
+	return !d.AnyKeyOf(predicate)
+}
+
+// each
+
+func (d *SortedDict[K, V]) Each(consumer util.Consumer[V]) {
+	d.EachKey(util.ConsumeOnSecondArg[K](consumer))
+}
+
+func (d *SortedDict[K, V]) EachKey(consumer util.BiConsumer[K, V]) {
+	walkNode(d.getRoot(), func(n *sortedNode[K, V]) bool {
+		consumer(n.key, n.value)
+		return true
+	})
+}
+
+// EachRange visits in order the entries whose key is in [from, to)
+func (d *SortedDict[K, V]) EachRange(from K, to K, consumer util.BiConsumer[K, V]) {
+	if d.Len() == 0 {
+		return
+	}
+	walkRangeNode(d.root, from, to, d.comparator, func(n *sortedNode[K, V]) { consumer(n.key, n.value) })
+}
+
+// find
+
+func (d *SortedDict[K, V]) FindKey(key K) bool {
+	return d.find(key) != nil
+}
+
+func (d *SortedDict[K, V]) FindValueFromKey(key K) (V, bool) {
+	_, value, found := entryOf(d.find(key))
+	return value, found
+}
+
+func (d *SortedDict[K, V]) Find(value V) bool {
+	_, found := d.FindKeyFromValue(value)
+	return found
+}
+
+func (d *SortedDict[K, V]) FindKeyFromValue(value V) (K, bool) {
+	key, _, found := d.FindIfKey(func(_ K, v V) bool { return util.DeepEqual(v, value) })
+	return key, found
+}
+
+func (d *SortedDict[K, V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	_, v, found := d.FindIfKey(util.TestOnSecondArg[K](predicate))
+	return v, found
+}
+
+func (d *SortedDict[K, V]) FindIfKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	var found *sortedNode[K, V]
+	walkNode(d.getRoot(), func(n *sortedNode[K, V]) bool {
+		if predicate(n.key, n.value) {
+			found = n
+			return false
+		}
+		return true
+	})
+	return entryOf(found)
+}
+
+func (d *SortedDict[K, V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return d.FindIf(util.Not(predicate))
+}
+
+func (d *SortedDict[K, V]) FindIfNotKey(predicate util.BiPredicate[K, V]) (K, V, bool) {
+	return d.FindIfKey(util.BiNot(predicate))
+}
+
+// copy
+
+func (d *SortedDict[K, V]) Copy() *SortedDict[K, V] {
+	if d == nil {
+		return nil
+	}
+	return &SortedDict[K, V]{comparator: d.comparator, root: cloneNode(d.root)}
+}
+
+func (d *SortedDict[K, V]) CopyIf(predicate util.Predicate[V]) *SortedDict[K, V] {
+	return d.CopyIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *SortedDict[K, V]) CopyIfKey(predicate util.BiPredicate[K, V]) *SortedDict[K, V] {
+	if d == nil {
+		return nil
+	}
+	copy := NewSorted[K, V](d.comparator)
+	d.EachKey(func(k K, v V) {
+		if predicate(k, v) {
+			copy.Add(k, v)
+		}
+	})
+	return copy
+}
+
+func (d *SortedDict[K, V]) CopyIfNot(predicate util.Predicate[V]) *SortedDict[K, V] {
+	return d.CopyIf(util.Not(predicate))
+}
+
+func (d *SortedDict[K, V]) CopyIfNotKey(predicate util.BiPredicate[K, V]) *SortedDict[K, V] {
+	return d.CopyIfKey(util.BiNot(predicate))
+}
+
+// modifier
+
+func (d *SortedDict[K, V]) Add(key K, value V) bool {
+	if d == nil {
+		return false
+	}
+	var overriden bool
+	d.root, overriden = insertNode(d.root, key, value, d.comparator)
+	return overriden
+}
+
+func (d *SortedDict[K, V]) Remove(key K) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	var removed bool
+	d.root, removed = deleteNode(d.root, key, d.comparator)
+	return removed
+}
+
+func (d *SortedDict[K, V]) PopFirst() (K, V, bool) {
+	key, value, found := d.First()
+	if found {
+		d.root = deleteMinNode(d.root)
+	}
+	return key, value, found
+}
+
+func (d *SortedDict[K, V]) PopLast() (K, V, bool) {
+	key, value, found := d.Last()
+	if found {
+		d.Remove(key)
+	}
+	return key, value, found
+}
+
+func (d *SortedDict[K, V]) Clear() bool {
+	if d.Len() == 0 {
+		return false
+	}
+	d.root = nil
+	return true
+}
+
+func (d *SortedDict[K, V]) RemoveIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIfKey(util.TestOnSecondArg[K](predicate))
+}
+
+func (d *SortedDict[K, V]) RemoveIfKey(predicate util.BiPredicate[K, V]) bool {
+	if d.Len() == 0 {
+		return false
+	}
+	keys := make([]K, 0, d.Len())
+	d.EachKey(func(k K, v V) {
+		if predicate(k, v) {
+			keys = append(keys, k)
+		}
+	})
+	for _, k := range keys {
+		d.Remove(k)
+	}
+	return len(keys) > 0
+}
+
+func (d *SortedDict[K, V]) KeepIf(predicate util.Predicate[V]) bool {
+	return d.RemoveIf(util.Not(predicate))
+}
+
+func (d *SortedDict[K, V]) KeepIfKey(predicate util.BiPredicate[K, V]) bool {
+	return d.RemoveIfKey(util.BiNot(predicate))
+}
+
+// internal
+
+func (d *SortedDict[K, V]) getRoot() *sortedNode[K, V] {
+	if d == nil {
+		return nil
+	}
+	return d.root
+}
+
+func (d *SortedDict[K, V]) find(key K) *sortedNode[K, V] {
+	for n := d.getRoot(); n != nil; {
+		switch c := d.comparator(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (d *SortedDict[K, V]) search(key K, lower bool, inclusive bool) (K, V, bool) {
+	var best *sortedNode[K, V]
+	for n := d.getRoot(); n != nil; {
+		c := d.comparator(n.key, key)
+		if c == 0 && inclusive {
+			return n.key, n.value, true
+		}
+		if lower {
+			if c < 0 {
+				best = n
+				n = n.right
+			} else {
+				n = n.left
+			}
+		} else {
+			if c > 0 {
+				best = n
+				n = n.left
+			} else {
+				n = n.right
+			}
+		}
+	}
+	return entryOf(best)
+}
+
+func entryOf[K any, V any](n *sortedNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var noKey K
+		var noValue V
+		return noKey, noValue, false
+	}
+	return n.key, n.value, true
+}
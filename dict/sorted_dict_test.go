@@ -0,0 +1,171 @@
+package dict_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestSortedDict(t *testing.T) {
+
+	// builder
+
+	d := dict.NewSortedOrdered[int, Item]().
+		With(30, Item{Value: 21}).
+		With(10, Item{Value: 22}).
+		With(50, Item{Value: 23}).
+		With(20, Item{Value: 24})
+
+	require.Equal(t, []int{10, 20, 30, 50}, d.Keys(), "sorted keys")
+
+	// add
+
+	require.False(t, d.Add(40, Item{Value: 15}), "add 40-15")
+	require.True(t, d.Add(40, Item{Value: 17}), "override 40-17")
+
+	// remove
+
+	require.True(t, d.Remove(10), "remove 10")
+	require.False(t, d.Remove(10), "remove 10 twice")
+
+	// sorted[20:24,30:21,40:17,50:23]
+
+	require.Equal(t, []int{20, 30, 40, 50}, d.Keys(), "keys after remove")
+	require.Equal(t, []Item{{Value: 24}, {Value: 21}, {Value: 17}, {Value: 23}}, d.Values(), "values after remove")
+
+	// navigation
+
+	key, _, found := d.Floor(35)
+	require.Equal(t, 30, key, "floor 35")
+	require.True(t, found, "found floor 35")
+	key, _, _ = d.Floor(30)
+	require.Equal(t, 30, key, "floor 30")
+	_, _, found = d.Floor(15)
+	require.False(t, found, "found floor 15")
+
+	key, _, _ = d.Lower(30)
+	require.Equal(t, 20, key, "lower 30")
+	key, _, _ = d.Ceiling(35)
+	require.Equal(t, 40, key, "ceiling 35")
+	key, _, _ = d.Ceiling(40)
+	require.Equal(t, 40, key, "ceiling 40")
+	key, _, _ = d.Higher(40)
+	require.Equal(t, 50, key, "higher 40")
+	_, _, found = d.Higher(50)
+	require.False(t, found, "found higher 50")
+
+	key, _, _ = d.First()
+	require.Equal(t, 20, key, "first")
+	key, _, _ = d.Last()
+	require.Equal(t, 50, key, "last")
+
+	// rank / select
+
+	require.Equal(t, 0, d.Rank(5), "rank 5")
+	require.Equal(t, 2, d.Rank(40), "rank 40")
+	require.Equal(t, 3, d.Rank(45), "rank 45")
+	key, item, found := d.Select(2)
+	require.Equal(t, 40, key, "select 2")
+	require.Equal(t, Item{Value: 17}, item, "select 2 item")
+	require.True(t, found, "found select 2")
+	_, _, found = d.Select(4)
+	require.False(t, found, "found select 4")
+
+	// range
+
+	var keys []int
+	d.EachRange(25, 50, func(k int, _ Item) { keys = append(keys, k) })
+	require.Equal(t, []int{30, 40}, keys, "range [25,50)")
+
+	// predicate
+
+	require.True(t, d.AllOf(func(i Item) bool { return i.Value < 30 }), "all_of")
+	require.False(t, d.AllOf(func(i Item) bool { return i.Value < 20 }), "all_of")
+	require.True(t, d.NoneOf(func(i Item) bool { return i.Value < 10 }), "none_of")
+	require.True(t, d.AnyKeyOf(func(k int, _ Item) bool { return k == 50 }), "any_key_of")
+
+	// find
+
+	require.True(t, d.FindKey(30), "find 30")
+	require.False(t, d.FindKey(35), "find 35")
+	require.True(t, d.Find(Item{Value: 23}), "find 23")
+
+	key, item, found = d.FindIfKey(func(_ int, i Item) bool { return i.Value%2 == 1 })
+	require.Equal(t, 30, key, "first odd key in order")
+	require.Equal(t, Item{Value: 21}, item, "first odd item in order")
+	require.True(t, found, "found odd")
+
+	// copy if
+
+	copy := d.CopyIfKey(func(k int, _ Item) bool { return k >= 40 })
+	require.Equal(t, []int{40, 50}, copy.Keys(), "wrong keys after copy if")
+
+	// remove if
+
+	copy = d.Copy()
+	require.True(t, copy.RemoveIfKey(func(k int, _ Item) bool { return k < 40 }), "wrong updated!")
+	require.Equal(t, []int{40, 50}, copy.Keys(), "wrong keys after remove if")
+
+	// pop
+
+	key, _, found = copy.PopFirst()
+	require.Equal(t, 40, key, "pop first")
+	require.True(t, found, "found pop first")
+	key, _, found = copy.PopLast()
+	require.Equal(t, 50, key, "pop last")
+	require.True(t, found, "found pop last")
+	_, _, found = copy.PopLast()
+	require.False(t, found, "found pop last on empty")
+
+	// check source of copy
+
+	require.Equal(t, []int{20, 30, 40, 50}, d.Keys(), "source of copy has been modified")
+
+	// clear
+
+	require.Equal(t, 4, d.Len(), "len before clear")
+	require.True(t, d.Clear(), "wrong updated!")
+	require.True(t, d.IsEmpty(), "is_empty after clear")
+	require.False(t, d.Clear(), "wrong updated!")
+}
+
+func TestSortedDictComparator(t *testing.T) {
+	d := dict.NewSorted[string, int](util.Reverse(util.Compare[string])).
+		With("a", 1).
+		With("c", 3).
+		With("b", 2)
+	require.Equal(t, []string{"c", "b", "a"}, d.Keys(), "reverse keys")
+
+	// a comparator is required
+
+	require.Panics(t, func() { dict.NewSorted[string, int](nil) }, "nil comparator")
+}
+
+func TestSortedDictRandom(t *testing.T) {
+	rand.Seed(time.Now().UnixMicro())
+	d := dict.NewSortedOrdered[int, int]()
+	reference := dict.Dict[int, int]{}
+	for i := 0; i < 2000; i++ {
+		key := rand.Intn(500)
+		if rand.Intn(3) == 0 {
+			require.Equal(t, reference.Remove(key), d.Remove(key), "wrong removed")
+		} else {
+			require.Equal(t, reference.Add(key, i), d.Add(key, i), "wrong overriden")
+		}
+	}
+	keys := reference.Keys()
+	sort.Ints(keys)
+	require.Equal(t, keys, d.Keys(), "wrong keys")
+	for i, key := range keys {
+		require.Equal(t, i, d.Rank(key), "wrong rank")
+		gotKey, gotValue, _ := d.Select(i)
+		require.Equal(t, key, gotKey, "wrong select")
+		require.Equal(t, reference[key], gotValue, "wrong value")
+	}
+}
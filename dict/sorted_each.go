@@ -0,0 +1,77 @@
+package dict
+
+import (
+	"sort"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// note:
+//   - the comparator is required and a nil one panics, use the *Ordered variants for the natural order
+//   - ties of the comparator are broken by the deep hash of the values, so that the order is the same on every run
+//     whatever the iteration order of the map
+
+func SortedKeys[K comparable, V any, D ~map[K]V](d D, comparator util.Comparator[K]) []K {
+	keys := make([]K, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sortDeterministic(keys, comparator)
+	return keys
+}
+
+func SortedValues[K comparable, V any, D ~map[K]V](d D, comparator util.Comparator[V]) []V {
+	values := make([]V, 0, len(d))
+	for _, v := range d {
+		values = append(values, v)
+	}
+	sortDeterministic(values, comparator)
+	return values
+}
+
+func SortedEach[K comparable, V any, D ~map[K]V](d D, comparator util.Comparator[K], consumer util.Consumer[V]) {
+	SortedEachKey(d, comparator, util.ConsumeOnSecondArg[K](consumer))
+}
+
+func SortedEachKey[K comparable, V any, D ~map[K]V](d D, comparator util.Comparator[K], consumer util.BiConsumer[K, V]) {
+	for _, k := range SortedKeys(d, comparator) {
+		consumer(k, d[k])
+	}
+}
+
+func SortedKeysOrdered[K util.Ordered, V any, D ~map[K]V](d D) []K {
+	return SortedKeys(d, util.Compare[K])
+}
+
+func SortedValuesOrdered[K comparable, V util.Ordered, D ~map[K]V](d D) []V {
+	return SortedValues(d, util.Compare[V])
+}
+
+func SortedEachOrdered[K util.Ordered, V any, D ~map[K]V](d D, consumer util.Consumer[V]) {
+	SortedEach(d, util.Compare[K], consumer)
+}
+
+func SortedEachKeyOrdered[K util.Ordered, V any, D ~map[K]V](d D, consumer util.BiConsumer[K, V]) {
+	SortedEachKey(d, util.Compare[K], consumer)
+}
+
+// EachOrdered visits the keys of the dict in the given order, skipping the keys not in the dict
+//
+// note: the keys of the dict missing from the order are not visited
+func EachOrdered[K comparable, V any, D ~map[K]V](d D, keyOrder []K, consumer util.BiConsumer[K, V]) {
+	for _, k := range keyOrder {
+		if v, found := d[k]; found {
+			consumer(k, v)
+		}
+	}
+}
+
+// internal
+
+func sortDeterministic[V any](values []V, comparator util.Comparator[V]) {
+	if comparator == nil {
+		panic("dict: nil comparator")
+	}
+	comparator = util.ThenByHash(comparator)
+	sort.SliceStable(values, func(i, j int) bool { return comparator(values[i], values[j]) < 0 })
+}
@@ -0,0 +1,130 @@
+package dict_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestSortedKeysInt(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      dict.Dict[int, int64]
+		comparator util.Comparator[int]
+		wantKeys   []int
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			comparator: util.Compare[int],
+			wantKeys:   []int{},
+		},
+		"empty": {
+			items:      EmptyInt64Dict,
+			comparator: util.Compare[int],
+			wantKeys:   []int{},
+		},
+		"natural": {
+			items:      DefaultInt64Dict,
+			comparator: util.Compare[int],
+			wantKeys:   []int{10, 20, 30, 40, 50},
+		},
+		"reverse": {
+			items:      DefaultInt64Dict,
+			comparator: util.Reverse(util.Compare[int]),
+			wantKeys:   []int{50, 40, 30, 20, 10},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotKeys := testCase.items.SortedKeys(testCase.comparator)
+
+		// assert
+		require.Equal(t, testCase.wantKeys, gotKeys, "wrong keys!")
+	})
+}
+
+func TestSortedValues(t *testing.T) {
+
+	require.Equal(t, []int64{12, 21, 34, 52, 87}, DefaultInt64Dict.SortedValues(util.Compare[int64]), "natural values")
+	require.Equal(t, []int64{12, 21, 34, 52, 87}, dict.SortedValuesOrdered(DefaultInt64Dict), "ordered values")
+	require.Equal(t, []Item{{Value: 87}, {Value: 52}, {Value: 34}, {Value: 21}, {Value: 12}},
+		DefaultItemDict.SortedValues(util.Reverse(util.CompareBy(func(i Item) int64 { return i.Value }))), "reversed item values")
+}
+
+func TestSortedEach(t *testing.T) {
+
+	// values in key order
+
+	var values []int64
+	DefaultInt64Dict.SortedEach(util.Compare[int], func(v int64) { values = append(values, v) })
+	require.Equal(t, []int64{21, 12, 34, 87, 52}, values, "wrong values order!")
+
+	values = nil
+	dict.SortedEachOrdered(DefaultInt64Dict, func(v int64) { values = append(values, v) })
+	require.Equal(t, []int64{21, 12, 34, 87, 52}, values, "wrong ordered values order!")
+
+	// named string keys use the natural order
+
+	type Name string
+	var keys []Name
+	dict.SortedEachKeyOrdered(dict.DeepDict[Name, []int]{"b": nil, "c": nil, "a": nil}, func(k Name, _ []int) { keys = append(keys, k) })
+	require.Equal(t, []Name{"a", "b", "c"}, keys, "wrong named keys order!")
+	require.Equal(t, []Name{"a", "b", "c"}, dict.SortedKeysOrdered(dict.Dict[Name, int]{"b": 2, "c": 3, "a": 1}), "wrong named keys!")
+}
+
+func TestSortedTies(t *testing.T) {
+
+	type Player struct {
+		Rank int
+		Name string
+	}
+	byRank := util.CompareBy(func(p Player) int { return p.Rank })
+
+	// ties are sorted in the same order on every run
+
+	players := dict.Dict[string, Player]{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		players[name] = Player{Rank: len(players) % 2, Name: name}
+	}
+	want := players.SortedValues(byRank)
+	for i := 0; i < 20; i++ {
+		require.Equal(t, want, dict.Copy(players).SortedValues(byRank), "ties in another order!")
+	}
+	for i := 1; i < len(want); i++ {
+		require.LessOrEqual(t, want[i-1].Rank, want[i].Rank, "not sorted by rank!")
+	}
+
+	// a comparator is required
+
+	require.Panics(t, func() { players.SortedKeys(nil) }, "nil key comparator")
+	require.Panics(t, func() { players.SortedValues(nil) }, "nil value comparator")
+}
+
+func TestEachOrdered(t *testing.T) {
+
+	var keys []int
+	var values []int64
+	DefaultInt64Dict.EachOrdered([]int{30, 60, 10}, func(k int, v int64) {
+		keys = append(keys, k)
+		values = append(values, v)
+	})
+	require.Equal(t, []int{30, 10}, keys, "wrong keys!")
+	require.Equal(t, []int64{34, 21}, values, "wrong values!")
+}
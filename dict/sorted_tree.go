@@ -0,0 +1,183 @@
+package dict
+
+import "github.com/gvaligiani/al.go/util"
+
+// sortedNode is a node of an AVL tree, augmented with the size of its sub-tree for rank / select
+type sortedNode[K any, V any] struct {
+	key    K
+	value  V
+	left   *sortedNode[K, V]
+	right  *sortedNode[K, V]
+	height int
+	size   int
+}
+
+func nodeHeight[K any, V any](n *sortedNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func nodeSize[K any, V any](n *sortedNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *sortedNode[K, V]) update() {
+	n.height = 1 + nodeHeight(n.left)
+	if h := nodeHeight(n.right); h >= n.height {
+		n.height = 1 + h
+	}
+	n.size = 1 + nodeSize(n.left) + nodeSize(n.right)
+}
+
+func rotateLeft[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func rotateRight[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func rebalance[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	n.update()
+	switch factor := nodeHeight(n.left) - nodeHeight(n.right); {
+	case factor > 1:
+		if nodeHeight(n.left.left) < nodeHeight(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case factor < -1:
+		if nodeHeight(n.right.right) < nodeHeight(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func insertNode[K any, V any](n *sortedNode[K, V], key K, value V, comparator util.Comparator[K]) (*sortedNode[K, V], bool) {
+	if n == nil {
+		return &sortedNode[K, V]{key: key, value: value, height: 1, size: 1}, false
+	}
+	var overriden bool
+	switch c := comparator(key, n.key); {
+	case c < 0:
+		n.left, overriden = insertNode(n.left, key, value, comparator)
+	case c > 0:
+		n.right, overriden = insertNode(n.right, key, value, comparator)
+	default:
+		n.value = value
+		return n, true
+	}
+	if overriden {
+		return n, true
+	}
+	return rebalance(n), false
+}
+
+func deleteNode[K any, V any](n *sortedNode[K, V], key K, comparator util.Comparator[K]) (*sortedNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := comparator(key, n.key); {
+	case c < 0:
+		n.left, removed = deleteNode(n.left, key, comparator)
+	case c > 0:
+		n.right, removed = deleteNode(n.right, key, comparator)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// replace the node by the minimum of its right sub-tree
+		successor := minNode(n.right)
+		successor.right = deleteMinNode(n.right)
+		successor.left = n.left
+		return rebalance(successor), true
+	}
+	if !removed {
+		return n, false
+	}
+	return rebalance(n), true
+}
+
+func deleteMinNode[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = deleteMinNode(n.left)
+	return rebalance(n)
+}
+
+func minNode[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maxNode[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func cloneNode[K any, V any](n *sortedNode[K, V]) *sortedNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	clone := *n
+	clone.left = cloneNode(n.left)
+	clone.right = cloneNode(n.right)
+	return &clone
+}
+
+// walkNode visits the nodes in order, until the visitor returns false
+func walkNode[K any, V any](n *sortedNode[K, V], visitor func(*sortedNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return walkNode(n.left, visitor) && visitor(n) && walkNode(n.right, visitor)
+}
+
+// walkRangeNode visits in order the nodes whose key is in [from, to)
+func walkRangeNode[K any, V any](n *sortedNode[K, V], from K, to K, comparator util.Comparator[K], visitor func(*sortedNode[K, V])) {
+	if n == nil {
+		return
+	}
+	afterFrom := comparator(n.key, from) >= 0
+	beforeTo := comparator(n.key, to) < 0
+	if afterFrom {
+		walkRangeNode(n.left, from, to, comparator, visitor)
+	}
+	if afterFrom && beforeTo {
+		visitor(n)
+	}
+	if beforeTo {
+		walkRangeNode(n.right, from, to, comparator, visitor)
+	}
+}
@@ -0,0 +1,31 @@
+package list
+
+import (
+	"sort"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// note: all the functions below expect the list to be sorted according to the comparator
+
+func BinarySearch[V any, L ~[]V](l L, value V, comparator util.Comparator[V]) (int, bool) {
+	index := LowerBound(l, value, comparator)
+	if index < len(l) && comparator(l[index], value) == 0 {
+		return index, true
+	}
+	return -1, false
+}
+
+func LowerBound[V any, L ~[]V](l L, value V, comparator util.Comparator[V]) int {
+	return sort.Search(len(l), func(i int) bool { return comparator(l[i], value) >= 0 })
+}
+
+func UpperBound[V any, L ~[]V](l L, value V, comparator util.Comparator[V]) int {
+	return sort.Search(len(l), func(i int) bool { return comparator(l[i], value) > 0 })
+}
+
+func EqualRange[V any, L ~[]V](l L, value V, comparator util.Comparator[V]) (int, int) {
+	lower := LowerBound(l, value, comparator)
+	upper := lower + UpperBound(l[lower:], value, comparator)
+	return lower, upper
+}
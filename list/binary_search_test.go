@@ -0,0 +1,136 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+var SortedInt64List = list.List[int64]{12, 21, 21, 21, 34, 52, 87}
+
+func TestBinarySearchInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		value     int64
+		wantIndex int
+		wantFound bool
+		wantLower int
+		wantUpper int
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			value:     21,
+			wantIndex: -1,
+			wantFound: false,
+			wantLower: 0,
+			wantUpper: 0,
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			value:     21,
+			wantIndex: -1,
+			wantFound: false,
+			wantLower: 0,
+			wantUpper: 0,
+		},
+		"first": {
+			items:     SortedInt64List,
+			value:     12,
+			wantIndex: 0,
+			wantFound: true,
+			wantLower: 0,
+			wantUpper: 1,
+		},
+		"duplicates": {
+			items:     SortedInt64List,
+			value:     21,
+			wantIndex: 1,
+			wantFound: true,
+			wantLower: 1,
+			wantUpper: 4,
+		},
+		"last": {
+			items:     SortedInt64List,
+			value:     87,
+			wantIndex: 6,
+			wantFound: true,
+			wantLower: 6,
+			wantUpper: 7,
+		},
+		"missing-before": {
+			items:     SortedInt64List,
+			value:     5,
+			wantIndex: -1,
+			wantFound: false,
+			wantLower: 0,
+			wantUpper: 0,
+		},
+		"missing-middle": {
+			items:     SortedInt64List,
+			value:     40,
+			wantIndex: -1,
+			wantFound: false,
+			wantLower: 5,
+			wantUpper: 5,
+		},
+		"missing-after": {
+			items:     SortedInt64List,
+			value:     99,
+			wantIndex: -1,
+			wantFound: false,
+			wantLower: 7,
+			wantUpper: 7,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotIndex, gotFound := list.BinarySearch(testCase.items, testCase.value, util.Compare[int64])
+		gotLower := list.LowerBound(testCase.items, testCase.value, util.Compare[int64])
+		gotUpper := list.UpperBound(testCase.items, testCase.value, util.Compare[int64])
+		gotRangeLower, gotRangeUpper := list.EqualRange(testCase.items, testCase.value, util.Compare[int64])
+
+		// assert
+		require.Equal(t, testCase.wantIndex, gotIndex, "wrong index!")
+		require.Equal(t, testCase.wantFound, gotFound, "wrong found!")
+		require.Equal(t, testCase.wantLower, gotLower, "wrong lower bound!")
+		require.Equal(t, testCase.wantUpper, gotUpper, "wrong upper bound!")
+		require.Equal(t, testCase.wantLower, gotRangeLower, "wrong range lower!")
+		require.Equal(t, testCase.wantUpper, gotRangeUpper, "wrong range upper!")
+	})
+}
+
+func TestBinarySearchStruct(t *testing.T) {
+
+	byValue := util.CompareBy(func(i Item) int64 { return i.Value })
+	items := list.New(
+		Item{Value: 12},
+		Item{Value: 21},
+		Item{Value: 34},
+	)
+
+	index, found := items.BinarySearch(Item{Value: 34}, byValue)
+	require.Equal(t, 2, index, "index 34")
+	require.True(t, found, "found 34")
+
+	index, found = items.BinarySearch(Item{Value: 35}, byValue)
+	require.Equal(t, -1, index, "index 35")
+	require.False(t, found, "found 35")
+}
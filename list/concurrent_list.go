@@ -0,0 +1,218 @@
+package list
+
+import (
+	"sync"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// ConcurrentList is a list safe for concurrent use, guarded by a read-write mutex
+//
+// note:
+//   - iterations and predicates run on a snapshot taken under the read lock,
+//     so consumers can modify the list without deadlocking
+//   - predicates of RemoveIf / KeepIf and comparators of Sort are called under the write lock,
+//     so they must not access the list
+//   - WithLock / WithRLock give access to the inner list for multi-step transactions
+type ConcurrentList[V comparable] struct {
+	mutex sync.RWMutex
+	items List[V]
+}
+
+// builder
+
+func NewConcurrent[V comparable](values ...V) *ConcurrentList[V] {
+	return &ConcurrentList[V]{items: New(values...)}
+}
+
+func (l *ConcurrentList[V]) With(value V) *ConcurrentList[V] {
+	l.Add(value)
+	return l
+}
+
+// getter
+
+func (l *ConcurrentList[V]) Len() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return len(l.items)
+}
+
+func (l *ConcurrentList[V]) Snapshot() List[V] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	if l.items == nil {
+		return List[V]{}
+	}
+	return l.items.Copy()
+}
+
+// state
+
+func (l *ConcurrentList[V]) IsEmpty() bool {
+	return l.Len() == 0
+}
+
+func (l *ConcurrentList[V]) AllOf(predicate util.Predicate[V]) bool {
+	return l.Snapshot().AllOf(predicate)
+}
+
+func (l *ConcurrentList[V]) AllIndexOf(predicate util.BiPredicate[int, V]) bool {
+	return l.Snapshot().AllIndexOf(predicate)
+}
+
+func (l *ConcurrentList[V]) AnyOf(predicate util.Predicate[V]) bool {
+	return l.Snapshot().AnyOf(predicate)
+}
+
+func (l *ConcurrentList[V]) AnyIndexOf(predicate util.BiPredicate[int, V]) bool {
+	return l.Snapshot().AnyIndexOf(predicate)
+}
+
+func (l *ConcurrentList[V]) NoneOf(predicate util.Predicate[V]) bool {
+	return l.Snapshot().NoneOf(predicate)
+}
+
+func (l *ConcurrentList[V]) NoIndexOf(predicate util.BiPredicate[int, V]) bool {
+	return l.Snapshot().NoIndexOf(predicate)
+}
+
+// each
+
+func (l *ConcurrentList[V]) Each(consumer util.Consumer[V]) {
+	l.Snapshot().Each(consumer)
+}
+
+func (l *ConcurrentList[V]) EachIndex(consumer util.BiConsumer[int, V]) {
+	l.Snapshot().EachIndex(consumer)
+}
+
+func (l *ConcurrentList[V]) EachWhile(consumer util.Predicate[V]) bool {
+	return l.Snapshot().EachWhile(consumer)
+}
+
+func (l *ConcurrentList[V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return l.Snapshot().EachErr(consumer)
+}
+
+// find
+
+func (l *ConcurrentList[V]) FindValueFromIndex(index int) (V, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.items.FindValueFromIndex(index)
+}
+
+func (l *ConcurrentList[V]) Find(value V) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.items.Find(value)
+}
+
+func (l *ConcurrentList[V]) FindIndexFromValue(value V) (int, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.items.FindIndexFromValue(value)
+}
+
+func (l *ConcurrentList[V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	return l.Snapshot().FindIf(predicate)
+}
+
+func (l *ConcurrentList[V]) FindIfIndex(predicate util.BiPredicate[int, V]) (int, V, bool) {
+	return l.Snapshot().FindIfIndex(predicate)
+}
+
+func (l *ConcurrentList[V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return l.Snapshot().FindIfNot(predicate)
+}
+
+func (l *ConcurrentList[V]) FindIfNotIndex(predicate util.BiPredicate[int, V]) (int, V, bool) {
+	return l.Snapshot().FindIfNotIndex(predicate)
+}
+
+// copy
+
+func (l *ConcurrentList[V]) Copy() *ConcurrentList[V] {
+	return &ConcurrentList[V]{items: l.Snapshot()}
+}
+
+func (l *ConcurrentList[V]) CopyIf(predicate util.Predicate[V]) *ConcurrentList[V] {
+	return &ConcurrentList[V]{items: l.Snapshot().CopyIf(predicate)}
+}
+
+func (l *ConcurrentList[V]) CopyIfNot(predicate util.Predicate[V]) *ConcurrentList[V] {
+	return &ConcurrentList[V]{items: l.Snapshot().CopyIfNot(predicate)}
+}
+
+// modifier
+
+func (l *ConcurrentList[V]) Add(value V) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.Add(value)
+}
+
+func (l *ConcurrentList[V]) Remove(value V) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.Remove(value)
+}
+
+func (l *ConcurrentList[V]) StableRemove(value V) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.StableRemove(value)
+}
+
+func (l *ConcurrentList[V]) Clear() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.Clear()
+}
+
+func (l *ConcurrentList[V]) RemoveIf(predicate util.Predicate[V]) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.RemoveIf(predicate)
+}
+
+func (l *ConcurrentList[V]) StableRemoveIf(predicate util.Predicate[V]) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.StableRemoveIf(predicate)
+}
+
+func (l *ConcurrentList[V]) KeepIf(predicate util.Predicate[V]) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.KeepIf(predicate)
+}
+
+func (l *ConcurrentList[V]) StableKeepIf(predicate util.Predicate[V]) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items.StableKeepIf(predicate)
+}
+
+func (l *ConcurrentList[V]) Sort(comparator util.Comparator[V]) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.items.Sort(comparator)
+}
+
+// transaction
+
+// WithLock runs the function with an exclusive access to the inner list
+func (l *ConcurrentList[V]) WithLock(transaction func(inner *List[V])) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	transaction(&l.items)
+}
+
+// WithRLock runs the function with a shared read-only access to the inner list
+func (l *ConcurrentList[V]) WithRLock(transaction func(inner List[V])) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	transaction(l.items)
+}
@@ -0,0 +1,110 @@
+package list_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestConcurrentList(t *testing.T) {
+
+	// builder
+
+	l := list.NewConcurrent[int64](21, 12).With(34)
+
+	// add / remove
+
+	require.True(t, l.Add(87), "add 87")
+	require.True(t, l.Add(52), "add 52")
+	require.True(t, l.StableRemove(12), "remove 12")
+	require.False(t, l.StableRemove(12), "remove 12 twice")
+
+	// list{21,34,87,52}
+
+	// predicate
+
+	require.True(t, l.AllOf(func(v int64) bool { return v > 20 }), "all_of")
+	require.True(t, l.AnyOf(func(v int64) bool { return v == 34 }), "any_of")
+	require.True(t, l.NoneOf(func(v int64) bool { return v > 100 }), "none_of")
+
+	// find
+
+	value, found := l.FindValueFromIndex(2)
+	require.Equal(t, int64(87), value, "value at 2")
+	require.True(t, found, "found at 2")
+	index, found := l.FindIndexFromValue(52)
+	require.Equal(t, 3, index, "index of 52")
+	require.True(t, found, "found 52")
+	index, value, found = l.FindIfIndex(func(_ int, v int64) bool { return v%2 == 0 })
+	require.Equal(t, 1, index, "index of first even")
+	require.Equal(t, int64(34), value, "first even")
+	require.True(t, found, "found even")
+
+	// each can modify the list
+
+	l.Each(func(v int64) { l.Add(v + 1) })
+	require.Equal(t, 8, l.Len(), "len after add in each")
+	l.StableKeepIf(func(v int64) bool { return v < 100 && v%10 != 3 && v%10 != 5 && v%10 != 8 && v != 22 })
+
+	// copy
+
+	evens := l.CopyIf(func(v int64) bool { return v%2 == 0 })
+	require.Equal(t, list.New[int64](34, 52), evens.Snapshot(), "wrong evens")
+	require.Equal(t, list.New[int64](21, 34, 87, 52), l.Snapshot(), "source of copy has been modified")
+
+	// sort
+
+	l.Sort(util.Compare[int64])
+	require.Equal(t, list.New[int64](21, 34, 52, 87), l.Snapshot(), "sorted")
+
+	// transaction
+
+	l.WithLock(func(inner *list.List[int64]) {
+		if value, found := inner.FindValueFromIndex(0); found {
+			inner.StableRemove(value)
+			inner.Add(value * 10)
+		}
+	})
+	l.WithRLock(func(inner list.List[int64]) {
+		require.Equal(t, list.New[int64](34, 52, 87, 210), inner, "after transaction")
+	})
+
+	// clear
+
+	require.False(t, l.IsEmpty(), "is_empty before clear")
+	require.True(t, l.Clear(), "clear")
+	require.True(t, l.IsEmpty(), "is_empty after clear")
+	require.False(t, l.Clear(), "clear twice")
+}
+
+func TestConcurrentListRace(t *testing.T) {
+
+	const goroutines = 16
+	const values = 1000
+
+	l := list.NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < values; i++ {
+				l.Add(i)
+				l.FindValueFromIndex(i)
+				if i%100 == 0 {
+					l.AnyOf(func(v int) bool { return v < 0 })
+					l.WithLock(func(inner *list.List[int]) { inner.Add(-1) })
+					l.Remove(-1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, goroutines*values, l.Len(), "wrong len")
+	require.True(t, l.AllOf(func(v int) bool { return v >= 0 }), "transaction values removed")
+}
@@ -0,0 +1,21 @@
+package list
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func CountBy[V any, K comparable, L ~[]V](l L, key util.Transformer[V, K]) dict.Dict[K, int] {
+	return CountByIndex(l, func(_ int, v V) K { return key(v) })
+}
+
+func CountByIndex[V any, K comparable, L ~[]V](l L, key util.BiTransformer[int, V, K]) dict.Dict[K, int] {
+	if l == nil {
+		return nil
+	}
+	counts := dict.Dict[K, int]{}
+	for i, v := range l {
+		counts[key(i, v)]++
+	}
+	return counts
+}
@@ -0,0 +1,57 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestCountByStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      list.List[Item]
+		key        util.Transformer[Item, bool]
+		wantCounts dict.Dict[bool, int]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			key:        func(i Item) bool { return i.Value%2 == 0 },
+			wantCounts: nil,
+		},
+		"empty": {
+			items:      EmptyItemList,
+			key:        func(i Item) bool { return i.Value%2 == 0 },
+			wantCounts: dict.Dict[bool, int]{},
+		},
+		"even": {
+			items:      DefaultItemList,
+			key:        func(i Item) bool { return i.Value%2 == 0 },
+			wantCounts: dict.Dict[bool, int]{true: 3, false: 2},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotCounts := list.CountBy(testCase.items, testCase.key)
+
+		// assert
+		require.Equal(t, testCase.wantCounts, gotCounts, "wrong counts!")
+	})
+}
@@ -0,0 +1,8 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+// DeepClone recursively copies the list and its values, see util.DeepClone
+func DeepClone[V any, L ~[]V](l L) L {
+	return util.DeepClone(l)
+}
@@ -0,0 +1,128 @@
+package list_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestDeepCloneStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items list.DeepList[*Item]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items: nil,
+		},
+		"empty": {
+			items: list.DeepList[*Item]{},
+		},
+		"default": {
+			items: list.DeepList[*Item](DefaultItemPointerList),
+		},
+		"with-nil": {
+			items: list.NewDeep(&Item{Value: 21}, nil),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		clone := testCase.items.DeepClone()
+
+		// assert
+		require.Equal(t, testCase.items, clone, "wrong clone!")
+		for i, item := range clone {
+			if item != nil {
+				require.NotSame(t, testCase.items[i], item, "item %d shared", i)
+			}
+		}
+	})
+}
+
+type Node struct {
+	Name     string
+	Children []*Node
+	Parent   *Node
+	Tags     map[string][]string
+	Any      any
+}
+
+type Versioned struct {
+	Value   int
+	Clones  *int
+	private []int
+}
+
+func (v Versioned) Clone() Versioned {
+	*v.Clones++
+	return Versioned{Value: v.Value + 1000, Clones: v.Clones, private: v.private}
+}
+
+func TestDeepClone(t *testing.T) {
+
+	// nested slices and maps
+
+	root := &Node{Name: "root", Tags: map[string][]string{"a": {"x"}}}
+	child := &Node{Name: "child", Parent: root}
+	root.Children = []*Node{child, child}
+	root.Any = []any{root, map[string]any{"n": 1}}
+
+	clone := list.DeepClone(list.DeepList[*Node]{root, child})
+	cloneRoot, cloneChild := clone[0], clone[1]
+
+	require.NotSame(t, root, cloneRoot, "root shared")
+	require.Equal(t, "root", cloneRoot.Name, "wrong root name")
+	cloneRoot.Tags["a"][0] = "y"
+	require.Equal(t, "x", root.Tags["a"][0], "nested slice in map shared")
+	cloneRoot.Any.([]any)[1].(map[string]any)["n"] = 2
+	require.Equal(t, 1, root.Any.([]any)[1].(map[string]any)["n"], "map in interface shared")
+
+	// cycles and sharing are kept
+
+	require.Same(t, cloneRoot, cloneChild.Parent, "cycle not kept")
+	require.Same(t, cloneChild, cloneRoot.Children[0], "sharing across list items not kept")
+	require.Same(t, cloneRoot.Children[0], cloneRoot.Children[1], "sharing not kept")
+	require.Same(t, cloneRoot, cloneRoot.Any.([]any)[0], "cycle through interface not kept")
+
+	// cloner is used at any depth, unexported fields are copied as is
+
+	clones := 0
+	values := list.DeepList[any]{Versioned{Value: 1, Clones: &clones, private: []int{1}}, []Versioned{{Value: 2, Clones: &clones}}}
+	clonedValues := values.DeepClone()
+	require.Equal(t, 2, clones, "cloner not called")
+	require.Equal(t, 1001, clonedValues[0].(Versioned).Value, "cloner not used")
+	require.Equal(t, 1002, clonedValues[1].([]Versioned)[0].Value, "nested cloner not used")
+
+	type Private struct {
+		Public  []int
+		private []int
+		when    time.Time
+	}
+	now := time.Now()
+	private := list.DeepList[Private]{{Public: []int{1}, private: []int{2}, when: now}}
+	clonedPrivate := private.DeepClone()
+	clonedPrivate[0].Public[0] = 10
+	require.Equal(t, 1, private[0].Public[0], "public field shared")
+	require.Equal(t, []int{2}, clonedPrivate[0].private, "private field not copied")
+	require.True(t, now.Equal(clonedPrivate[0].when), "time not copied")
+
+	// nil interface
+
+	require.Nil(t, list.DeepList[any]{nil}.DeepClone()[0], "nil interface")
+}
@@ -61,6 +61,22 @@ func (l DeepList[V]) EachIndex(consumer util.BiConsumer[int, V]) {
 	EachIndex(l, consumer)
 }
 
+func (l DeepList[V]) EachWhile(consumer util.Predicate[V]) bool {
+	return EachWhile(l, consumer)
+}
+
+func (l DeepList[V]) EachIndexWhile(consumer util.BiPredicate[int, V]) bool {
+	return EachIndexWhile(l, consumer)
+}
+
+func (l DeepList[V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return EachErr(l, consumer)
+}
+
+func (l DeepList[V]) EachIndexErr(consumer util.BiErrConsumer[int, V]) error {
+	return EachIndexErr(l, consumer)
+}
+
 // find
 
 func (l DeepList[V]) FindIndex(index int) bool {
@@ -101,6 +117,10 @@ func (l DeepList[V]) Copy() DeepList[V] {
 	return DeepList[V](Copy(l))
 }
 
+func (l DeepList[V]) DeepClone() DeepList[V] {
+	return DeepClone(l)
+}
+
 func (l DeepList[V]) CopyIf(predicate util.Predicate[V]) DeepList[V] {
 	return DeepList[V](CopyIf(l, predicate))
 }
@@ -117,6 +137,48 @@ func (l DeepList[V]) CopyIfNotIndex(predicate util.BiPredicate[int, V]) DeepList
 	return DeepList[V](CopyIfNotIndex(l, predicate))
 }
 
+func (l DeepList[V]) Partition(predicate util.Predicate[V]) (DeepList[V], DeepList[V]) {
+	return Partition(l, predicate)
+}
+
+func (l DeepList[V]) PartitionIndex(predicate util.BiPredicate[int, V]) (DeepList[V], DeepList[V]) {
+	return PartitionIndex(l, predicate)
+}
+
+// sort
+
+func (l DeepList[V]) IsSorted(comparator util.Comparator[V]) bool {
+	return IsSorted(l, comparator)
+}
+
+func (l DeepList[V]) Sort(comparator util.Comparator[V]) {
+	Sort(l, comparator)
+}
+
+func (l DeepList[V]) SortStable(comparator util.Comparator[V]) {
+	SortStable(l, comparator)
+}
+
+func (l DeepList[V]) SortFunc(less util.BiPredicate[V, V]) {
+	SortFunc(l, less)
+}
+
+func (l DeepList[V]) BinarySearch(value V, comparator util.Comparator[V]) (int, bool) {
+	return BinarySearch(l, value, comparator)
+}
+
+func (l DeepList[V]) LowerBound(value V, comparator util.Comparator[V]) int {
+	return LowerBound(l, value, comparator)
+}
+
+func (l DeepList[V]) UpperBound(value V, comparator util.Comparator[V]) int {
+	return UpperBound(l, value, comparator)
+}
+
+func (l DeepList[V]) EqualRange(value V, comparator util.Comparator[V]) (int, int) {
+	return EqualRange(l, value, comparator)
+}
+
 // modifier
 
 func (l *DeepList[V]) Add(value V) bool {
@@ -124,10 +186,18 @@ func (l *DeepList[V]) Add(value V) bool {
 	return true
 }
 
+func (l *DeepList[V]) InsertSorted(value V, comparator util.Comparator[V]) int {
+	return InsertSorted(l, value, comparator)
+}
+
 func (l *DeepList[V]) Remove(value V) bool {
 	return RemoveIf(l, func(v V) bool { return util.DeepEqual(v, value) })
 }
 
+func (l *DeepList[V]) StableRemove(value V) bool {
+	return StableRemoveIf(l, func(v V) bool { return util.DeepEqual(v, value) })
+}
+
 func (l *DeepList[V]) Clear() bool {
 	if l == nil || len(*l) == 0 {
 		return false
@@ -151,3 +221,19 @@ func (l *DeepList[V]) KeepIf(predicate util.Predicate[V]) bool {
 func (l *DeepList[V]) KeepIfIndex(predicate util.BiPredicate[int, V]) bool {
 	return KeepIfIndex(l, predicate)
 }
+
+func (l *DeepList[V]) StableRemoveIf(predicate util.Predicate[V]) bool {
+	return StableRemoveIf(l, predicate)
+}
+
+func (l *DeepList[V]) StableRemoveIfIndex(predicate util.BiPredicate[int, V]) bool {
+	return StableRemoveIfIndex(l, predicate)
+}
+
+func (l *DeepList[V]) StableKeepIf(predicate util.Predicate[V]) bool {
+	return StableKeepIf(l, predicate)
+}
+
+func (l *DeepList[V]) StableKeepIfIndex(predicate util.BiPredicate[int, V]) bool {
+	return StableKeepIfIndex(l, predicate)
+}
@@ -0,0 +1,245 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// Operation is the kind of an Edit
+type Operation int
+
+const (
+	Keep Operation = iota
+	Insert
+	Delete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case Keep:
+		return "keep"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+	return fmt.Sprintf("operation(%d)", int(o))
+}
+
+// Edit is one step of an EditScript
+//
+// note: LeftIndex is -1 for an insert, RightIndex is -1 for a delete
+type Edit[V any] struct {
+	Operation  Operation
+	LeftIndex  int
+	RightIndex int
+	Value      V
+}
+
+// EditScript is the shortest sequence of edits turning the left list into the right list
+type EditScript[V any] []Edit[V]
+
+func Diff[V comparable, L ~[]V](left L, right L) EditScript[V] {
+	return DiffFn(left, right, util.Equal[V])
+}
+
+func DeepDiff[V any, L ~[]V](left L, right L) EditScript[V] {
+	return DiffFn(left, right, util.DeepEqual[V])
+}
+
+// DiffFn computes the edit script with the linear space variant of the Myers algorithm,
+// in O((N+M)D) time where D is the number of inserts and deletes, and O(N+M) space
+func DiffFn[V any, L ~[]V](left L, right L, equal util.BiPredicate[V, V]) EditScript[V] {
+	offset := (len(left)+len(right)+1)/2 + 1
+	d := &differ[V]{
+		left:     left,
+		right:    right,
+		equal:    equal,
+		offset:   offset,
+		forward:  make([]int, 2*offset+1),
+		backward: make([]int, 2*offset+1),
+		script:   make(EditScript[V], 0, len(left)+len(right)),
+	}
+	d.compare(0, len(left), 0, len(right))
+	return d.script
+}
+
+// differ holds the state of a diff: the furthest reaching paths of the middle snake search and the script built so far
+//
+// note: forward[offset+k] is the furthest x reached on the diagonal k = x - y from the start,
+// backward[offset+k] is the furthest distance from the end reached on the diagonal k counted from the end
+type differ[V any] struct {
+	left     []V
+	right    []V
+	equal    util.BiPredicate[V, V]
+	offset   int
+	forward  []int
+	backward []int
+	script   EditScript[V]
+}
+
+// compare appends the edits turning left[x0:x1] into right[y0:y1], splitting the lists on their middle snake
+func (d *differ[V]) compare(x0 int, x1 int, y0 int, y1 int) {
+
+	// common prefix and suffix
+	for x0 < x1 && y0 < y1 && d.equal(d.left[x0], d.right[y0]) {
+		d.keep(x0, y0)
+		x0++
+		y0++
+	}
+	suffix := 0
+	for x0 < x1-suffix && y0 < y1-suffix && d.equal(d.left[x1-suffix-1], d.right[y1-suffix-1]) {
+		suffix++
+	}
+	x1 -= suffix
+	y1 -= suffix
+
+	// note: once trimmed, both lists are either empty or differ by at least two edits, so both halves are smaller
+	switch {
+	case x0 == x1:
+		for y := y0; y < y1; y++ {
+			d.script = append(d.script, Edit[V]{Operation: Insert, LeftIndex: -1, RightIndex: y, Value: d.right[y]})
+		}
+	case y0 == y1:
+		for x := x0; x < x1; x++ {
+			d.script = append(d.script, Edit[V]{Operation: Delete, LeftIndex: x, RightIndex: -1, Value: d.left[x]})
+		}
+	default:
+		startX, startY, endX, endY := d.middleSnake(x0, x1, y0, y1)
+		d.compare(x0, startX, y0, startY)
+		for x, y := startX, startY; x < endX; x, y = x+1, y+1 {
+			d.keep(x, y)
+		}
+		d.compare(endX, x1, endY, y1)
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.keep(x1+i, y1+i)
+	}
+}
+
+func (d *differ[V]) keep(x int, y int) {
+	d.script = append(d.script, Edit[V]{Operation: Keep, LeftIndex: x, RightIndex: y, Value: d.left[x]})
+}
+
+// middleSnake extends the furthest reaching paths from both ends of left[x0:x1] and right[y0:y1] until they overlap,
+// and returns the bounds of the snake where they meet
+func (d *differ[V]) middleSnake(x0 int, x1 int, y0 int, y1 int) (int, int, int, int) {
+	n, m := x1-x0, y1-y0
+	delta := n - m
+	odd := delta%2 != 0
+	maxD := (n+m+1)/2 + 1
+	for i := d.offset - maxD; i <= d.offset+maxD; i++ {
+		d.forward[i] = 0
+		d.backward[i] = 0
+	}
+
+	for step := 0; step < maxD; step++ {
+
+		// forward paths
+		for k := -step; k <= step; k += 2 {
+			x := nextX(d.forward, d.offset, step, k)
+			startX, startY := x, x-k
+			y := startY
+			for x < n && y < m && d.equal(d.left[x0+x], d.right[y0+y]) {
+				x++
+				y++
+			}
+			d.forward[d.offset+k] = x
+			if odd && delta-k >= -(step-1) && delta-k <= step-1 && x+d.backward[d.offset+delta-k] >= n {
+				return x0 + startX, y0 + startY, x0 + x, y0 + y
+			}
+		}
+
+		// backward paths
+		for k := -step; k <= step; k += 2 {
+			x := nextX(d.backward, d.offset, step, k)
+			endX, endY := x, x-k
+			y := endY
+			for x < n && y < m && d.equal(d.left[x1-x-1], d.right[y1-y-1]) {
+				x++
+				y++
+			}
+			d.backward[d.offset+k] = x
+			if !odd && delta-k >= -step && delta-k <= step && x+d.forward[d.offset+delta-k] >= n {
+				return x1 - x, y1 - y, x1 - endX, y1 - endY
+			}
+		}
+	}
+	panic("list: middle snake not found")
+}
+
+// nextX returns the x a path on the diagonal k starts from at step d, moving down from k+1 or right from k-1
+func nextX(frontier []int, offset int, d int, k int) int {
+	if k == -d || (k != d && frontier[offset+k-1] < frontier[offset+k+1]) {
+		return frontier[offset+k+1]
+	}
+	return frontier[offset+k-1] + 1
+}
+
+// Distance returns the number of inserts and deletes
+func (s EditScript[V]) Distance() int {
+	distance := 0
+	for _, e := range s {
+		if e.Operation != Keep {
+			distance++
+		}
+	}
+	return distance
+}
+
+// CompactScript is the shippable form of an edit script: its inserts and deletes, without the kept values,
+// and the length of the base it applies to
+type CompactScript[V any] struct {
+	BaseLen int
+	Edits   EditScript[V]
+}
+
+// Compact returns the compact form of a full edit script
+func (s EditScript[V]) Compact() CompactScript[V] {
+	return CompactScript[V]{BaseLen: s.baseLen(), Edits: s.edits()}
+}
+
+// edits returns the inserts and deletes of the script
+func (s EditScript[V]) edits() EditScript[V] {
+	if s == nil {
+		return nil
+	}
+	edits := make(EditScript[V], 0, s.Distance())
+	for _, e := range s {
+		if e.Operation != Keep {
+			edits = append(edits, e)
+		}
+	}
+	return edits
+}
+
+// baseLen returns the length of the left list of a full script, which is kept or deleted
+func (s EditScript[V]) baseLen() int {
+	size := 0
+	for _, e := range s {
+		if e.Operation != Insert {
+			size++
+		}
+	}
+	return size
+}
+
+// String renders one line per edit: "  value" for a keep, "+ value" for an insert, "- value" for a delete
+func (s EditScript[V]) String() string {
+	var b strings.Builder
+	for _, e := range s {
+		switch e.Operation {
+		case Keep:
+			b.WriteString("  ")
+		case Insert:
+			b.WriteString("+ ")
+		case Delete:
+			b.WriteString("- ")
+		}
+		fmt.Fprintf(&b, "%v\n", e.Value)
+	}
+	return b.String()
+}
@@ -0,0 +1,192 @@
+package list_test
+
+import (
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestDiffInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		left         list.List[int64]
+		right        list.List[int64]
+		wantDistance int
+		wantText     string
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			left:         nil,
+			right:        nil,
+			wantDistance: 0,
+			wantText:     "",
+		},
+		"equal": {
+			left:         DefaultInt64List,
+			right:        DefaultInt64List,
+			wantDistance: 0,
+			wantText:     "  21\n  12\n  34\n  87\n  52\n",
+		},
+		"from-empty": {
+			left:         EmptyInt64List,
+			right:        list.New[int64](21, 12),
+			wantDistance: 2,
+			wantText:     "+ 21\n+ 12\n",
+		},
+		"to-empty": {
+			left:         list.New[int64](21, 12),
+			right:        nil,
+			wantDistance: 2,
+			wantText:     "- 21\n- 12\n",
+		},
+		"with-other": {
+			left:         DefaultInt64List,
+			right:        OtherInt64List,
+			wantDistance: 2,
+			wantText:     "  21\n  12\n- 34\n  87\n  52\n+ 69\n",
+		},
+		"replace": {
+			left:         list.New[int64](1, 2, 3),
+			right:        list.New[int64](1, 4, 3),
+			wantDistance: 2,
+			wantText:     "  1\n- 2\n+ 4\n  3\n",
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		script := list.Diff(testCase.left, testCase.right)
+
+		// assert
+		require.Equal(t, testCase.wantDistance, script.Distance(), "wrong distance!")
+		require.Equal(t, testCase.wantText, script.String(), "wrong text!")
+	})
+}
+
+func TestDiffIndexes(t *testing.T) {
+
+	script := list.Diff(list.New("a", "b", "c"), list.New("b", "c", "d"))
+
+	require.Equal(t, list.EditScript[string]{
+		{Operation: list.Delete, LeftIndex: 0, RightIndex: -1, Value: "a"},
+		{Operation: list.Keep, LeftIndex: 1, RightIndex: 0, Value: "b"},
+		{Operation: list.Keep, LeftIndex: 2, RightIndex: 1, Value: "c"},
+		{Operation: list.Insert, LeftIndex: -1, RightIndex: 2, Value: "d"},
+	}, script, "wrong script!")
+	require.Equal(t, "delete", list.Delete.String(), "wrong operation name!")
+}
+
+func TestDiffFn(t *testing.T) {
+
+	// case insensitive equality
+
+	script := list.DiffFn(list.New("A", "b"), list.New("a", "B", "c"), strings.EqualFold)
+	require.Equal(t, 1, script.Distance(), "wrong distance!")
+	require.Equal(t, "  A\n  b\n+ c\n", script.String(), "wrong text!")
+
+	// deep equality on pointers
+
+	deepScript := list.DeepDiff(DefaultItemPointerList, OtherItemPointerList)
+	require.Equal(t, 2, deepScript.Distance(), "wrong deep distance!")
+}
+
+func TestDiffRandom(t *testing.T) {
+
+	random := rand.New(rand.NewSource(42))
+	randomList := func() list.List[int] {
+		l := make(list.List[int], random.Intn(30))
+		for i := range l {
+			l[i] = random.Intn(5)
+		}
+		return l
+	}
+
+	for i := 0; i < 500; i++ {
+		left, right := randomList(), randomList()
+		script := list.Diff(left, right)
+
+		// the script turns left into right
+
+		gotLeft, gotRight := list.List[int]{}, list.List[int]{}
+		for _, e := range script {
+			if e.Operation != list.Insert {
+				require.Equal(t, left[e.LeftIndex], e.Value, "wrong left index")
+				gotLeft = append(gotLeft, e.Value)
+			}
+			if e.Operation != list.Delete {
+				require.Equal(t, right[e.RightIndex], e.Value, "wrong right index")
+				gotRight = append(gotRight, e.Value)
+			}
+		}
+		require.Equal(t, append([]int{}, left...), []int(gotLeft), "left not rebuilt")
+		require.Equal(t, append([]int{}, right...), []int(gotRight), "right not rebuilt")
+
+		// the script is the shortest
+
+		require.Equal(t, len(left)+len(right)-2*lcs(left, right), script.Distance(), "script not the shortest")
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+
+	const size = 5000
+	left, right := make(list.List[int], size), make(list.List[int], size)
+	for i := 0; i < size; i++ {
+		left[i] = i
+		right[i] = size + i
+	}
+
+	// fully different lists are diffed in linear space
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	script := list.Diff(left, right)
+	runtime.ReadMemStats(&after)
+
+	require.Equal(t, 2*size, script.Distance(), "wrong distance!")
+	require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(10<<20), "too much memory allocated")
+
+	// a few differences in large lists
+
+	right = append(list.List[int]{-1}, left...)
+	right[size/2] = -2
+	right = append(right, -3)
+	script = list.Diff(left, right)
+	require.Equal(t, 4, script.Distance(), "wrong distance on close lists!")
+}
+
+func lcs(left []int, right []int) int {
+	lengths := make([][]int, len(left)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(right)+1)
+	}
+	for i := len(left) - 1; i >= 0; i-- {
+		for j := len(right) - 1; j >= 0; j-- {
+			if left[i] == right[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] > lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	return lengths[0][0]
+}
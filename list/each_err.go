@@ -0,0 +1,16 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func EachErr[V any, L ~[]V](l L, consumer util.ErrConsumer[V]) error {
+	return EachIndexErr(l, func(_ int, v V) error { return consumer(v) })
+}
+
+func EachIndexErr[V any, L ~[]V](l L, consumer util.BiErrConsumer[int, V]) error {
+	for i, v := range l {
+		if err := consumer(i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
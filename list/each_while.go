@@ -0,0 +1,16 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func EachWhile[V any, L ~[]V](l L, consumer util.Predicate[V]) bool {
+	return EachIndexWhile(l, util.TestOnSecondArg[int](consumer))
+}
+
+func EachIndexWhile[V any, L ~[]V](l L, consumer util.BiPredicate[int, V]) bool {
+	for i, v := range l {
+		if !consumer(i, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestEachWhileInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items         list.List[int64]
+		limit         int
+		wantCompleted bool
+		wantVisited   int
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:         nil,
+			limit:         2,
+			wantCompleted: true,
+			wantVisited:   0,
+		},
+		"empty": {
+			items:         EmptyInt64List,
+			limit:         2,
+			wantCompleted: true,
+			wantVisited:   0,
+		},
+		"stop": {
+			items:         DefaultInt64List,
+			limit:         2,
+			wantCompleted: false,
+			wantVisited:   2,
+		},
+		"all": {
+			items:         DefaultInt64List,
+			limit:         10,
+			wantCompleted: true,
+			wantVisited:   5,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotVisited := 0
+		gotCompleted := list.EachWhile(testCase.items, func(item int64) bool {
+			gotVisited++
+			return gotVisited < testCase.limit
+		})
+
+		// assert
+		require.Equal(t, testCase.wantCompleted, gotCompleted, "wrong completed!")
+		require.Equal(t, testCase.wantVisited, gotVisited, "wrong visited!")
+	})
+}
+
+func TestEachIndexWhileStruct(t *testing.T) {
+
+	var indexes []int
+	completed := DefaultItemList.EachIndexWhile(func(i int, item Item) bool {
+		indexes = append(indexes, i)
+		return item.Value != 34
+	})
+	require.False(t, completed, "wrong completed!")
+	require.Equal(t, []int{0, 1, 2}, indexes, "wrong indexes!")
+}
@@ -0,0 +1,106 @@
+package list_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/list"
+)
+
+// Version implements util.Equaler, ignoring the case of its tags
+type Version struct {
+	Major int
+	Minor int
+	Tags  []string
+}
+
+func (v Version) Equal(other Version) bool {
+	if v.Major != other.Major || v.Minor != other.Minor || len(v.Tags) != len(other.Tags) {
+		return false
+	}
+	for i, tag := range v.Tags {
+		if !strings.EqualFold(tag, other.Tags[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// PlainVersion is the same structure, compared through reflection
+type PlainVersion struct {
+	Major int
+	Minor int
+	Tags  []string
+}
+
+func TestEqualer(t *testing.T) {
+
+	versions := list.DeepList[Version]{
+		{Major: 1, Minor: 0, Tags: []string{"stable"}},
+		{Major: 1, Minor: 1, Tags: []string{"beta", "rc"}},
+	}
+
+	// find
+
+	require.True(t, list.DeepFind(versions, Version{Major: 1, Minor: 1, Tags: []string{"BETA", "RC"}}), "find uses equaler")
+	require.False(t, list.DeepFind(versions, Version{Major: 1, Minor: 1, Tags: []string{"beta"}}), "find other tags")
+
+	// equal
+
+	require.True(t, list.DeepEqual(versions, list.DeepList[Version]{
+		{Major: 1, Minor: 0, Tags: []string{"Stable"}},
+		{Major: 1, Minor: 1, Tags: []string{"Beta", "Rc"}},
+	}), "equal uses equaler")
+
+	// remove
+
+	require.True(t, versions.Remove(Version{Major: 1, Minor: 0, Tags: []string{"STABLE"}}), "remove uses equaler")
+	require.Len(t, versions, 1, "len after remove")
+
+	// fallback on reflection
+
+	plains := list.DeepList[PlainVersion]{{Major: 1, Minor: 1, Tags: []string{"beta"}}}
+	require.True(t, plains.Find(PlainVersion{Major: 1, Minor: 1, Tags: []string{"beta"}}), "find through reflection")
+	require.False(t, plains.Find(PlainVersion{Major: 1, Minor: 1, Tags: []string{"BETA"}}), "reflection is case sensitive")
+}
+
+//
+// benchmarks
+//
+
+func BenchmarkEqualer(b *testing.B) {
+	const size = 1000
+	versions := make(list.DeepList[Version], size)
+	plains := make(list.DeepList[PlainVersion], size)
+	for i := 0; i < size; i++ {
+		versions[i] = Version{Major: i, Minor: i % 10, Tags: []string{"v", fmt.Sprint(i)}}
+		plains[i] = PlainVersion{Major: i, Minor: i % 10, Tags: []string{"v", fmt.Sprint(i)}}
+	}
+	last := size - 1
+
+	b.Run("find/equaler", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list.DeepFind(versions, versions[last])
+		}
+	})
+	b.Run("find/reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			list.DeepFind(plains, plains[last])
+		}
+	})
+	b.Run("remove/equaler", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			versions.Remove(versions[last])
+			versions.Add(Version{Major: last, Minor: last % 10, Tags: []string{"v", fmt.Sprint(last)}})
+		}
+	})
+	b.Run("remove/reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			plains.Remove(plains[last])
+			plains.Add(PlainVersion{Major: last, Minor: last % 10, Tags: []string{"v", fmt.Sprint(last)}})
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package list
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func GroupBy[V any, K comparable, L ~[]V](l L, key util.Transformer[V, K]) dict.DeepDict[K, DeepList[V]] {
+	return GroupByIndex(l, func(_ int, v V) K { return key(v) })
+}
+
+func GroupByIndex[V any, K comparable, L ~[]V](l L, key util.BiTransformer[int, V, K]) dict.DeepDict[K, DeepList[V]] {
+	if l == nil {
+		return nil
+	}
+	// note: the order of the values is kept inside each group
+	groups := dict.DeepDict[K, DeepList[V]]{}
+	for i, v := range l {
+		k := key(i, v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
@@ -0,0 +1,79 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestGroupByInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      list.List[int64]
+		key        util.Transformer[int64, int64]
+		wantGroups dict.DeepDict[int64, list.DeepList[int64]]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			key:        func(i int64) int64 { return i % 3 },
+			wantGroups: nil,
+		},
+		"empty": {
+			items:      EmptyInt64List,
+			key:        func(i int64) int64 { return i % 3 },
+			wantGroups: dict.DeepDict[int64, list.DeepList[int64]]{},
+		},
+		"modulo-3": {
+			items: DefaultInt64List,
+			key:   func(i int64) int64 { return i % 3 },
+			wantGroups: dict.DeepDict[int64, list.DeepList[int64]]{
+				0: list.NewDeep[int64](21, 12, 87),
+				1: list.NewDeep[int64](34, 52),
+			},
+		},
+		"single-group": {
+			items: DefaultInt64List,
+			key:   func(i int64) int64 { return 0 },
+			wantGroups: dict.DeepDict[int64, list.DeepList[int64]]{
+				0: list.NewDeep[int64](21, 12, 34, 87, 52),
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotGroups := list.GroupBy(testCase.items, testCase.key)
+
+		// assert
+		require.Equal(t, testCase.wantGroups, gotGroups, "wrong groups!")
+	})
+}
+
+func TestGroupByIndexStruct(t *testing.T) {
+
+	// execute
+	gotGroups := list.GroupByIndex(DefaultItemList, func(i int, _ Item) bool { return i < 2 })
+
+	// assert
+	require.Equal(t, dict.DeepDict[bool, list.DeepList[Item]]{
+		true:  list.NewDeep(Item{Value: 21}, Item{Value: 12}),
+		false: list.NewDeep(Item{Value: 34}, Item{Value: 87}, Item{Value: 52}),
+	}, gotGroups, "wrong groups!")
+}
@@ -0,0 +1,28 @@
+package list
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func IndexBy[V any, K comparable, L ~[]V](l L, key util.Transformer[V, K], resolver dict.Resolver[K, V]) dict.DeepDict[K, V] {
+	return IndexByIndex(l, func(_ int, v V) K { return key(v) }, resolver)
+}
+
+func IndexByIndex[V any, K comparable, L ~[]V](l L, key util.BiTransformer[int, V, K], resolver dict.Resolver[K, V]) dict.DeepDict[K, V] {
+	if l == nil {
+		return nil
+	}
+	// note:
+	//  - the resolver is called with the values in list order when two values share the same key
+	//  - a nil resolver keeps the last value
+	index := make(dict.DeepDict[K, V], len(l))
+	for i, v := range l {
+		k := key(i, v)
+		if old, found := index[k]; found && resolver != nil {
+			v = resolver(k, old, v)
+		}
+		index[k] = v
+	}
+	return index
+}
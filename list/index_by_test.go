@@ -0,0 +1,66 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestIndexByInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		resolver  dict.Resolver[int64, int64]
+		wantIndex dict.DeepDict[int64, int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			resolver:  nil,
+			wantIndex: nil,
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			resolver:  nil,
+			wantIndex: dict.DeepDict[int64, int64]{},
+		},
+		"keep-last": {
+			items:     DefaultInt64List,
+			resolver:  nil,
+			wantIndex: dict.DeepDict[int64, int64]{0: 87, 1: 52},
+		},
+		"keep-first": {
+			items:     DefaultInt64List,
+			resolver:  func(_ int64, old int64, _ int64) int64 { return old },
+			wantIndex: dict.DeepDict[int64, int64]{0: 21, 1: 34},
+		},
+		"sum": {
+			items:     DefaultInt64List,
+			resolver:  func(_ int64, old int64, new int64) int64 { return old + new },
+			wantIndex: dict.DeepDict[int64, int64]{0: 120, 1: 86},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotIndex := list.IndexBy(testCase.items, func(i int64) int64 { return i % 3 }, testCase.resolver)
+
+		// assert
+		require.Equal(t, testCase.wantIndex, gotIndex, "wrong index!")
+	})
+}
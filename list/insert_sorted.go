@@ -0,0 +1,16 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func InsertSorted[V any, L ~[]V](l *L, value V, comparator util.Comparator[V]) int {
+	if l == nil {
+		return -1
+	}
+	// note: the value is inserted after its equals, so that insertion order is kept among them
+	index := UpperBound(*l, value, comparator)
+	var empty V
+	*l = append(*l, empty)
+	copy((*l)[index+1:], (*l)[index:])
+	(*l)[index] = value
+	return index
+}
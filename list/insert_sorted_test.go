@@ -0,0 +1,90 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestInsertSortedInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		value     int64
+		wantIndex int
+		wantItems list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			value:     21,
+			wantIndex: 0,
+			wantItems: list.New[int64](21),
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			value:     21,
+			wantIndex: 0,
+			wantItems: list.New[int64](21),
+		},
+		"first": {
+			items:     list.New[int64](12, 21, 34),
+			value:     5,
+			wantIndex: 0,
+			wantItems: list.New[int64](5, 12, 21, 34),
+		},
+		"middle": {
+			items:     list.New[int64](12, 21, 34),
+			value:     25,
+			wantIndex: 2,
+			wantItems: list.New[int64](12, 21, 25, 34),
+		},
+		"duplicate": {
+			items:     list.New[int64](12, 21, 34),
+			value:     21,
+			wantIndex: 2,
+			wantItems: list.New[int64](12, 21, 21, 34),
+		},
+		"last": {
+			items:     list.New[int64](12, 21, 34),
+			value:     99,
+			wantIndex: 3,
+			wantItems: list.New[int64](12, 21, 34, 99),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Copy(testCase.items)
+		gotIndex := list.InsertSorted(&gotItems, testCase.value, util.Compare[int64])
+
+		// assert
+		require.Equal(t, testCase.wantIndex, gotIndex, "wrong index!")
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestInsertSortedStructPointer(t *testing.T) {
+
+	byValue := util.CompareBy(func(i *Item) int64 { return i.Value })
+	items := list.NewDeep[*Item]()
+	for _, value := range []int64{34, 12, 87, 21} {
+		items.InsertSorted(&Item{Value: value}, byValue)
+	}
+	assertDeepEqual(t, list.NewDeep(&Item{Value: 12}, &Item{Value: 21}, &Item{Value: 34}, &Item{Value: 87}), items, "wrong items!")
+}
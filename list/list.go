@@ -61,6 +61,22 @@ func (l List[V]) EachIndex(consumer util.BiConsumer[int, V]) {
 	EachIndex(l, consumer)
 }
 
+func (l List[V]) EachWhile(consumer util.Predicate[V]) bool {
+	return EachWhile(l, consumer)
+}
+
+func (l List[V]) EachIndexWhile(consumer util.BiPredicate[int, V]) bool {
+	return EachIndexWhile(l, consumer)
+}
+
+func (l List[V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return EachErr(l, consumer)
+}
+
+func (l List[V]) EachIndexErr(consumer util.BiErrConsumer[int, V]) error {
+	return EachIndexErr(l, consumer)
+}
+
 // find
 
 func (l List[V]) FindIndex(index int) bool {
@@ -117,6 +133,48 @@ func (l List[V]) CopyIfNotIndex(predicate util.BiPredicate[int, V]) List[V] {
 	return List[V](CopyIfNotIndex(l, predicate))
 }
 
+func (l List[V]) Partition(predicate util.Predicate[V]) (List[V], List[V]) {
+	return Partition(l, predicate)
+}
+
+func (l List[V]) PartitionIndex(predicate util.BiPredicate[int, V]) (List[V], List[V]) {
+	return PartitionIndex(l, predicate)
+}
+
+// sort
+
+func (l List[V]) IsSorted(comparator util.Comparator[V]) bool {
+	return IsSorted(l, comparator)
+}
+
+func (l List[V]) Sort(comparator util.Comparator[V]) {
+	Sort(l, comparator)
+}
+
+func (l List[V]) SortStable(comparator util.Comparator[V]) {
+	SortStable(l, comparator)
+}
+
+func (l List[V]) SortFunc(less util.BiPredicate[V, V]) {
+	SortFunc(l, less)
+}
+
+func (l List[V]) BinarySearch(value V, comparator util.Comparator[V]) (int, bool) {
+	return BinarySearch(l, value, comparator)
+}
+
+func (l List[V]) LowerBound(value V, comparator util.Comparator[V]) int {
+	return LowerBound(l, value, comparator)
+}
+
+func (l List[V]) UpperBound(value V, comparator util.Comparator[V]) int {
+	return UpperBound(l, value, comparator)
+}
+
+func (l List[V]) EqualRange(value V, comparator util.Comparator[V]) (int, int) {
+	return EqualRange(l, value, comparator)
+}
+
 // modifier
 
 func (l *List[V]) Add(value V) bool {
@@ -124,10 +182,18 @@ func (l *List[V]) Add(value V) bool {
 	return true
 }
 
+func (l *List[V]) InsertSorted(value V, comparator util.Comparator[V]) int {
+	return InsertSorted(l, value, comparator)
+}
+
 func (l *List[V]) Remove(value V) bool {
 	return RemoveIf(l, func(v V) bool { return util.Equal(v, value) })
 }
 
+func (l *List[V]) StableRemove(value V) bool {
+	return StableRemoveIf(l, func(v V) bool { return util.Equal(v, value) })
+}
+
 func (l *List[V]) Clear() bool {
 	if l == nil || len(*l) == 0 {
 		return false
@@ -151,3 +217,19 @@ func (l *List[V]) KeepIf(predicate util.Predicate[V]) bool {
 func (l *List[V]) KeepIfIndex(predicate util.BiPredicate[int, V]) bool {
 	return KeepIfIndex(l, predicate)
 }
+
+func (l *List[V]) StableRemoveIf(predicate util.Predicate[V]) bool {
+	return StableRemoveIf(l, predicate)
+}
+
+func (l *List[V]) StableRemoveIfIndex(predicate util.BiPredicate[int, V]) bool {
+	return StableRemoveIfIndex(l, predicate)
+}
+
+func (l *List[V]) StableKeepIf(predicate util.Predicate[V]) bool {
+	return StableKeepIf(l, predicate)
+}
+
+func (l *List[V]) StableKeepIfIndex(predicate util.BiPredicate[int, V]) bool {
+	return StableKeepIfIndex(l, predicate)
+}
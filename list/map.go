@@ -0,0 +1,18 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func Map[V any, O any, L ~[]V](l L, transformer util.Transformer[V, O]) DeepList[O] {
+	return MapIndex(l, func(_ int, v V) O { return transformer(v) })
+}
+
+func MapIndex[V any, O any, L ~[]V](l L, transformer util.BiTransformer[int, V, O]) DeepList[O] {
+	if l == nil {
+		return nil
+	}
+	mapped := make(DeepList[O], 0, len(l))
+	for i, v := range l {
+		mapped = append(mapped, transformer(i, v))
+	}
+	return mapped
+}
@@ -0,0 +1,100 @@
+package list_test
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestMapInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       list.List[int64]
+		transformer util.Transformer[int64, string]
+		wantItems   list.DeepList[string]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(i int64) string { return fmt.Sprint(i) },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyInt64List,
+			transformer: func(i int64) string { return fmt.Sprint(i) },
+			wantItems:   list.DeepList[string]{},
+		},
+		"to-string": {
+			items:       DefaultInt64List,
+			transformer: func(i int64) string { return fmt.Sprint(i) },
+			wantItems:   list.NewDeep("21", "12", "34", "87", "52"),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Map(testCase.items, testCase.transformer)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestMapIndexStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       list.List[Item]
+		transformer util.BiTransformer[int, Item, int64]
+		wantItems   list.DeepList[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(i int, item Item) int64 { return int64(i) + item.Value },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyItemList,
+			transformer: func(i int, item Item) int64 { return int64(i) + item.Value },
+			wantItems:   list.DeepList[int64]{},
+		},
+		"index-plus-value": {
+			items:       DefaultItemList,
+			transformer: func(i int, item Item) int64 { return int64(i) + item.Value },
+			wantItems:   list.NewDeep[int64](21, 13, 36, 90, 56),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.MapIndex(testCase.items, testCase.transformer)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
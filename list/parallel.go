@@ -0,0 +1,54 @@
+package list
+
+import (
+	"context"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// note: workers <= 0 means runtime.GOMAXPROCS(0) workers, see util.ParallelFor for cancellation and panics
+
+func ParallelEach[V any, L ~[]V](ctx context.Context, l L, workers int, consumer util.Consumer[V]) error {
+	return ParallelEachIndex(ctx, l, workers, util.ConsumeOnSecondArg[int](consumer))
+}
+
+func ParallelEachIndex[V any, L ~[]V](ctx context.Context, l L, workers int, consumer util.BiConsumer[int, V]) error {
+	return util.ParallelFor(ctx, len(l), workers, func(i int) { consumer(i, l[i]) })
+}
+
+func ParallelMap[V any, O any, L ~[]V](ctx context.Context, l L, workers int, transformer util.Transformer[V, O]) (DeepList[O], error) {
+	return ParallelMapIndex(ctx, l, workers, func(_ int, v V) O { return transformer(v) })
+}
+
+func ParallelMapIndex[V any, O any, L ~[]V](ctx context.Context, l L, workers int, transformer util.BiTransformer[int, V, O]) (DeepList[O], error) {
+	if l == nil {
+		return nil, ctx.Err()
+	}
+	mapped := make(DeepList[O], len(l))
+	if err := util.ParallelFor(ctx, len(l), workers, func(i int) { mapped[i] = transformer(i, l[i]) }); err != nil {
+		return nil, err
+	}
+	return mapped, nil
+}
+
+func ParallelCopyIf[V any, L ~[]V](ctx context.Context, l L, workers int, predicate util.Predicate[V]) (L, error) {
+	return ParallelCopyIfIndex(ctx, l, workers, util.TestOnSecondArg[int](predicate))
+}
+
+func ParallelCopyIfIndex[V any, L ~[]V](ctx context.Context, l L, workers int, predicate util.BiPredicate[int, V]) (L, error) {
+	if l == nil {
+		return nil, ctx.Err()
+	}
+	// note: predicates are evaluated in parallel, then kept values are collected in order
+	kept := make([]bool, len(l))
+	if err := util.ParallelFor(ctx, len(l), workers, func(i int) { kept[i] = predicate(i, l[i]) }); err != nil {
+		return nil, err
+	}
+	copy := make(L, 0, len(l))
+	for i, v := range l {
+		if kept[i] {
+			copy = append(copy, v)
+		}
+	}
+	return copy, nil
+}
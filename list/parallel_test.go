@@ -0,0 +1,193 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestParallelMapInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		workers   int
+		wantItems list.DeepList[string]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			workers:   4,
+			wantItems: nil,
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			workers:   4,
+			wantItems: list.DeepList[string]{},
+		},
+		"single-worker": {
+			items:     DefaultInt64List,
+			workers:   1,
+			wantItems: list.NewDeep("21", "12", "34", "87", "52"),
+		},
+		"more-workers-than-items": {
+			items:     DefaultInt64List,
+			workers:   16,
+			wantItems: list.NewDeep("21", "12", "34", "87", "52"),
+		},
+		"default-workers": {
+			items:     DefaultInt64List,
+			workers:   0,
+			wantItems: list.NewDeep("21", "12", "34", "87", "52"),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems, err := list.ParallelMap(context.Background(), testCase.items, testCase.workers, func(i int64) string { return fmt.Sprint(i) })
+
+		// assert
+		require.NoError(t, err, "wrong error!")
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestParallelCopyIfInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		predicate util.Predicate[int64]
+		wantItems list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			predicate: func(i int64) bool { return i%2 == 0 },
+			wantItems: nil,
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			predicate: func(i int64) bool { return i%2 == 0 },
+			wantItems: list.List[int64]{},
+		},
+		"keep-even": {
+			items:     DefaultInt64List,
+			predicate: func(i int64) bool { return i%2 == 0 },
+			wantItems: list.New[int64](12, 34, 52),
+		},
+		"keep-none": {
+			items:     DefaultInt64List,
+			predicate: func(i int64) bool { return i > 100 },
+			wantItems: list.List[int64]{},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems, err := list.ParallelCopyIf(context.Background(), testCase.items, 3, testCase.predicate)
+
+		// assert
+		require.NoError(t, err, "wrong error!")
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestParallelEach(t *testing.T) {
+
+	// every item is consumed once
+
+	l := make(list.List[int64], 10000)
+	for i := range l {
+		l[i] = int64(i)
+	}
+	var sum int64
+	err := list.ParallelEach(context.Background(), l, 8, func(i int64) { atomic.AddInt64(&sum, i) })
+	require.NoError(t, err, "wrong error!")
+	require.Equal(t, int64(10000*9999/2), sum, "wrong sum!")
+
+	// index matches value
+
+	err = list.ParallelEachIndex(context.Background(), l, 8, func(i int, v int64) {
+		if int64(i) != v {
+			panic("wrong index")
+		}
+	})
+	require.NoError(t, err, "wrong error!")
+}
+
+func TestParallelCancel(t *testing.T) {
+
+	l := make(list.List[int64], 10000)
+
+	// cancelled before start
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var count int64
+	err := list.ParallelEach(ctx, l, 4, func(int64) { atomic.AddInt64(&count, 1) })
+	require.ErrorIs(t, err, context.Canceled, "wrong error!")
+	require.Equal(t, int64(0), count, "items consumed after cancel")
+
+	// cancelled while running
+
+	ctx, cancel = context.WithCancel(context.Background())
+	count = 0
+	_, err = list.ParallelMap(ctx, l, 4, func(v int64) int64 {
+		if atomic.AddInt64(&count, 1) == 100 {
+			cancel()
+		}
+		return v
+	})
+	require.ErrorIs(t, err, context.Canceled, "wrong error!")
+	require.Less(t, count, int64(len(l)), "all items consumed")
+}
+
+func TestParallelPanic(t *testing.T) {
+
+	l := make(list.List[int64], 1000)
+	l[500] = 1
+	boom := errors.New("boom")
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "panic not propagated")
+		panicErr, ok := r.(*util.PanicError)
+		require.True(t, ok, "wrong panic value %v", r)
+		require.ErrorIs(t, panicErr, boom, "wrong panic cause")
+	}()
+
+	_, _ = list.ParallelCopyIf(context.Background(), l, 4, func(v int64) bool {
+		if v == 1 {
+			panic(boom)
+		}
+		return true
+	})
+}
@@ -0,0 +1,23 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func Partition[V any, L ~[]V](l L, predicate util.Predicate[V]) (L, L) {
+	return PartitionIndex(l, util.TestOnSecondArg[int](predicate))
+}
+
+func PartitionIndex[V any, L ~[]V](l L, predicate util.BiPredicate[int, V]) (L, L) {
+	if l == nil {
+		return nil, nil
+	}
+	matching := make(L, 0, len(l))
+	others := make(L, 0, len(l))
+	for i, v := range l {
+		if predicate(i, v) {
+			matching = append(matching, v)
+		} else {
+			others = append(others, v)
+		}
+	}
+	return matching, others
+}
@@ -0,0 +1,76 @@
+package list_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestPartitionInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items        list.List[int64]
+		predicate    util.Predicate[int64]
+		wantMatching list.List[int64]
+		wantOthers   list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:        nil,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: nil,
+			wantOthers:   nil,
+		},
+		"empty": {
+			items:        EmptyInt64List,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: list.List[int64]{},
+			wantOthers:   list.List[int64]{},
+		},
+		"even": {
+			items:        DefaultInt64List,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: list.New[int64](12, 34, 52),
+			wantOthers:   list.New[int64](21, 87),
+		},
+		"all": {
+			items:        DefaultInt64List,
+			predicate:    func(i int64) bool { return true },
+			wantMatching: list.New[int64](21, 12, 34, 87, 52),
+			wantOthers:   list.List[int64]{},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotMatching, gotOthers := testCase.items.Partition(testCase.predicate)
+
+		// assert
+		assertEqual(t, testCase.wantMatching, gotMatching, "wrong matching items!")
+		assertEqual(t, testCase.wantOthers, gotOthers, "wrong other items!")
+	})
+}
+
+func TestPartitionIndexStruct(t *testing.T) {
+
+	// execute
+	gotMatching, gotOthers := list.PartitionIndex(DefaultItemPointerList, func(i int, _ *Item) bool { return i%2 == 0 })
+
+	// assert
+	assertDeepEqual(t, list.List[*Item]{{Value: 21}, {Value: 34}, {Value: 52}}, gotMatching, "wrong matching items!")
+	assertDeepEqual(t, list.List[*Item]{{Value: 12}, {Value: 87}}, gotOthers, "wrong other items!")
+}
@@ -0,0 +1,165 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+// Patch turns a list equal to its base into its target, and back
+//
+// note:
+//   - Apply and Revert fail with dict.ErrConflict if the list does not match the script or the length of the base,
+//     leaving it unchanged
+//   - a patch rebuilt from a compact script only checks the deleted values and the length of the base:
+//     the kept elements are the elements of the list which are not deleted, in order
+type Patch[V any, L ~[]V] struct {
+	script EditScript[V]
+	base   int
+	equal  util.BiPredicate[V, V]
+}
+
+// builder
+
+func NewPatch[V comparable, L ~[]V](base L, target L) *Patch[V, L] {
+	return NewPatchFn(base, target, util.Equal[V])
+}
+
+func NewDeepPatch[V any, L ~[]V](base L, target L) *Patch[V, L] {
+	return NewPatchFn(base, target, util.DeepEqual[V])
+}
+
+func NewPatchFn[V any, L ~[]V](base L, target L, equal util.BiPredicate[V, V]) *Patch[V, L] {
+	return NewPatchFromScriptFn[V, L](DiffFn(base, target, equal), equal)
+}
+
+// NewPatchFromScript rebuilds a patch from its full script, for instance received from another service
+func NewPatchFromScript[V comparable, L ~[]V](script EditScript[V]) *Patch[V, L] {
+	return NewPatchFromScriptFn[V, L](script, util.Equal[V])
+}
+
+func NewDeepPatchFromScript[V any, L ~[]V](script EditScript[V]) *Patch[V, L] {
+	return NewPatchFromScriptFn[V, L](script, util.DeepEqual[V])
+}
+
+func NewPatchFromScriptFn[V any, L ~[]V](script EditScript[V], equal util.BiPredicate[V, V]) *Patch[V, L] {
+	return &Patch[V, L]{script: script, base: script.baseLen(), equal: equal}
+}
+
+// NewPatchFromCompact rebuilds a patch from its compact script, for instance received from another service
+func NewPatchFromCompact[V comparable, L ~[]V](compact CompactScript[V]) *Patch[V, L] {
+	return NewPatchFromCompactFn[V, L](compact, util.Equal[V])
+}
+
+func NewDeepPatchFromCompact[V any, L ~[]V](compact CompactScript[V]) *Patch[V, L] {
+	return NewPatchFromCompactFn[V, L](compact, util.DeepEqual[V])
+}
+
+func NewPatchFromCompactFn[V any, L ~[]V](compact CompactScript[V], equal util.BiPredicate[V, V]) *Patch[V, L] {
+	return &Patch[V, L]{script: compact.Edits, base: compact.BaseLen, equal: equal}
+}
+
+// getter
+
+func (p *Patch[V, L]) Script() EditScript[V] {
+	return p.script
+}
+
+// Compact returns the inserts and deletes of the patch with the length of its base, to be shipped without the kept values
+func (p *Patch[V, L]) Compact() CompactScript[V] {
+	return CompactScript[V]{BaseLen: p.base, Edits: p.script.edits()}
+}
+
+func (p *Patch[V, L]) IsEmpty() bool {
+	return p.script.Distance() == 0
+}
+
+func (p *Patch[V, L]) String() string {
+	return p.script.String()
+}
+
+// Inverse returns the patch from the target back to the base
+func (p *Patch[V, L]) Inverse() *Patch[V, L] {
+	inverse := &Patch[V, L]{script: make(EditScript[V], len(p.script)), base: p.base, equal: p.equal}
+	for i, e := range p.script {
+		switch e.Operation {
+		case Insert:
+			e.Operation = Delete
+			inverse.base++
+		case Delete:
+			e.Operation = Insert
+			inverse.base--
+		}
+		e.LeftIndex, e.RightIndex = e.RightIndex, e.LeftIndex
+		inverse.script[i] = e
+	}
+	return inverse
+}
+
+// modifier
+
+func (p *Patch[V, L]) Apply(target *L) error {
+	if target == nil {
+		return fmt.Errorf("%w: nil target", dict.ErrConflict)
+	}
+
+	// check the length of the base, then the kept and deleted values
+	if len(*target) != p.base {
+		return fmt.Errorf("%w: length is %d instead of %d", dict.ErrConflict, len(*target), p.base)
+	}
+	deleted := make([]bool, len(*target))
+	deletes, inserts := 0, 0
+	for _, e := range p.script {
+		if e.Operation == Insert {
+			inserts++
+			continue
+		}
+		if e.LeftIndex < 0 || e.LeftIndex >= len(*target) || !p.equal((*target)[e.LeftIndex], e.Value) {
+			return fmt.Errorf("%w: index %d is not %v", dict.ErrConflict, e.LeftIndex, e.Value)
+		}
+		if e.Operation == Keep {
+			continue
+		}
+		if deleted[e.LeftIndex] {
+			return fmt.Errorf("%w: index %d deleted twice", dict.ErrConflict, e.LeftIndex)
+		}
+		deleted[e.LeftIndex] = true
+		deletes++
+	}
+	kept := len(*target) - deletes
+
+	// place the inserted values, then fill the gaps with the kept ones
+	patched := make(L, kept+inserts)
+	inserted := make([]bool, len(patched))
+	for _, e := range p.script {
+		if e.Operation != Insert {
+			continue
+		}
+		if e.RightIndex < 0 || e.RightIndex >= len(patched) || inserted[e.RightIndex] {
+			return fmt.Errorf("%w: cannot insert %v at index %d", dict.ErrConflict, e.Value, e.RightIndex)
+		}
+		patched[e.RightIndex] = e.Value
+		inserted[e.RightIndex] = true
+	}
+	index := 0
+	for i, v := range *target {
+		if deleted[i] {
+			continue
+		}
+		for inserted[index] {
+			index++
+		}
+		patched[index] = v
+		index++
+	}
+	if *target == nil && len(patched) == 0 {
+		return nil
+	}
+	*target = patched
+	return nil
+}
+
+func (p *Patch[V, L]) Revert(target *L) error {
+	return p.Inverse().Apply(target)
+}
@@ -0,0 +1,143 @@
+package list_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+)
+
+func TestPatch(t *testing.T) {
+
+	patch := list.NewPatch(DefaultInt64List, OtherInt64List)
+	require.Equal(t, 2, patch.Script().Distance(), "wrong distance!")
+
+	// apply
+
+	target := DefaultInt64List.Copy()
+	require.NoError(t, patch.Apply(&target), "apply")
+	assertEqual(t, OtherInt64List, target, "wrong applied list!")
+
+	// apply twice conflicts and leaves the list unchanged
+
+	require.ErrorIs(t, patch.Apply(&target), dict.ErrConflict, "apply twice")
+	assertEqual(t, OtherInt64List, target, "list modified by a conflicting apply!")
+
+	// revert
+
+	require.NoError(t, patch.Revert(&target), "revert")
+	assertEqual(t, DefaultInt64List, target, "wrong reverted list!")
+
+	// conflict on length
+
+	longer := append(DefaultInt64List.Copy(), 1)
+	require.ErrorIs(t, patch.Apply(&longer), dict.ErrConflict, "apply to a longer list")
+
+	// empty patch
+
+	require.True(t, list.NewPatch(DefaultInt64List, DefaultInt64List).IsEmpty(), "is_empty")
+
+	// nil
+
+	var empty list.List[int64]
+	require.NoError(t, list.NewPatch(nil, DefaultInt64List).Apply(&empty), "apply to nil")
+	assertEqual(t, DefaultInt64List, empty, "wrong list applied to nil!")
+	require.NoError(t, list.NewPatch(DefaultInt64List, nil).Apply(&empty), "apply to empty")
+	require.Empty(t, empty, "wrong emptied list!")
+}
+
+func TestDeepPatch(t *testing.T) {
+
+	patch := list.NewDeepPatch(DefaultItemPointerList, OtherItemPointerList)
+
+	// an equal copy of the base can be patched, kept items are the ones of the target
+
+	target := list.List[*Item](list.Map(DefaultItemPointerList, func(i *Item) *Item { return &Item{Value: i.Value} }))
+	first := target[0]
+	require.NoError(t, patch.Apply(&target), "apply")
+	assertDeepEqual(t, OtherItemPointerList, target, "wrong applied list!")
+	require.Same(t, first, target[0], "kept item replaced")
+}
+
+func TestPatchFromScript(t *testing.T) {
+
+	sent := list.NewPatch(DefaultInt64List, OtherInt64List)
+
+	// the script is shipped as json and the patch rebuilt on the other side
+
+	data, err := json.Marshal(sent.Script())
+	require.NoError(t, err, "marshal script")
+
+	var script list.EditScript[int64]
+	require.NoError(t, json.Unmarshal(data, &script), "unmarshal script")
+	assertPatch(t, list.NewPatchFromScript[int64, list.List[int64]](script))
+}
+
+func TestPatchFromCompact(t *testing.T) {
+
+	sent := list.NewPatch(DefaultInt64List, OtherInt64List)
+
+	// a compact script only holds the inserts and deletes, and the length of the base
+
+	compact := sent.Compact()
+	require.Equal(t, len(DefaultInt64List), compact.BaseLen, "wrong base length!")
+	require.Equal(t, "- 34\n+ 69\n", compact.Edits.String(), "wrong compact text!")
+	require.Equal(t, compact, sent.Script().Compact(), "compact of patch and script differ!")
+
+	// the compact script is shipped as json and the patch rebuilt on the other side
+
+	data, err := json.Marshal(compact)
+	require.NoError(t, err, "marshal compact script")
+
+	var received list.CompactScript[int64]
+	require.NoError(t, json.Unmarshal(data, &received), "unmarshal compact script")
+	patch := list.NewPatchFromCompact[int64, list.List[int64]](received)
+	assertPatch(t, patch)
+
+	// the inverse patch can be compacted too
+
+	inverse := list.NewPatchFromCompact[int64, list.List[int64]](patch.Inverse().Compact())
+	target := OtherInt64List.Copy()
+	require.NoError(t, inverse.Apply(&target), "apply compact inverse")
+	assertEqual(t, DefaultInt64List, target, "wrong applied compact inverse!")
+
+	// deep patch
+
+	deep := list.NewDeepPatchFromCompact[*Item, list.List[*Item]](list.DeepDiff(DefaultItemPointerList, OtherItemPointerList).Compact())
+	deepTarget := list.List[*Item](DefaultItemPointerList.Copy())
+	require.NoError(t, deep.Apply(&deepTarget), "apply deep")
+	assertDeepEqual(t, OtherItemPointerList, deepTarget, "wrong applied deep list!")
+}
+
+func TestPatchBaseLength(t *testing.T) {
+
+	// a patch replacing the whole list has no keep edit, but still checks the length of the base
+
+	patch := list.NewPatch(list.New(1), list.New(2))
+	target := list.New(1, 5)
+	require.ErrorIs(t, patch.Apply(&target), dict.ErrConflict, "apply to a longer list")
+	assertEqual(t, list.New(1, 5), target, "list modified by a conflicting apply!")
+
+	compact := list.NewPatchFromCompact[int, list.List[int]](patch.Compact())
+	require.ErrorIs(t, compact.Apply(&target), dict.ErrConflict, "apply compact to a longer list")
+
+	// a compact patch detects kept elements added or removed
+
+	other := list.NewPatchFromCompact[int64, list.List[int64]](list.NewPatch(DefaultInt64List, OtherInt64List).Compact())
+	longer := append(DefaultInt64List.Copy(), 1)
+	require.ErrorIs(t, other.Apply(&longer), dict.ErrConflict, "apply compact with an added element")
+	shorter := DefaultInt64List.Copy()[:4]
+	require.ErrorIs(t, other.Apply(&shorter), dict.ErrConflict, "apply compact with a removed element")
+}
+
+func assertPatch(t *testing.T, patch *list.Patch[int64, list.List[int64]]) {
+	target := DefaultInt64List.Copy()
+	require.NoError(t, patch.Apply(&target), "apply")
+	assertEqual(t, OtherInt64List, target, "wrong applied list!")
+	require.ErrorIs(t, patch.Apply(&target), dict.ErrConflict, "apply twice")
+	require.NoError(t, patch.Revert(&target), "revert")
+	assertEqual(t, DefaultInt64List, target, "wrong reverted list!")
+}
@@ -0,0 +1,15 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func Reduce[V any, A any, L ~[]V](l L, initial A, reducer util.BiTransformer[A, V, A]) A {
+	return ReduceIndex(l, initial, func(acc A, _ int, v V) A { return reducer(acc, v) })
+}
+
+func ReduceIndex[V any, A any, L ~[]V](l L, initial A, reducer util.TriTransformer[A, int, V, A]) A {
+	acc := initial
+	for i, v := range l {
+		acc = reducer(acc, i, v)
+	}
+	return acc
+}
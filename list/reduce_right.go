@@ -0,0 +1,15 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func ReduceRight[V any, A any, L ~[]V](l L, initial A, reducer util.BiTransformer[A, V, A]) A {
+	return ReduceRightIndex(l, initial, func(acc A, _ int, v V) A { return reducer(acc, v) })
+}
+
+func ReduceRightIndex[V any, A any, L ~[]V](l L, initial A, reducer util.TriTransformer[A, int, V, A]) A {
+	acc := initial
+	for i := len(l) - 1; i >= 0; i-- {
+		acc = reducer(acc, i, l[i])
+	}
+	return acc
+}
@@ -0,0 +1,94 @@
+package list_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestReduceRightInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		wantItems list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			wantItems: EmptyInt64List,
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			wantItems: EmptyInt64List,
+		},
+		"reverse": {
+			items:     DefaultInt64List,
+			wantItems: list.New[int64](52, 87, 34, 12, 21),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.ReduceRight(testCase.items, list.List[int64]{}, func(acc list.List[int64], item int64) list.List[int64] {
+			return append(acc, item)
+		})
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestReduceRightIndexStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       list.List[Item]
+		wantIndexes list.List[int]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			wantIndexes: list.List[int]{},
+		},
+		"empty": {
+			items:       EmptyItemList,
+			wantIndexes: list.List[int]{},
+		},
+		"reverse": {
+			items:       DefaultItemList,
+			wantIndexes: list.New(4, 3, 2, 1, 0),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotIndexes := list.ReduceRightIndex(testCase.items, list.List[int]{}, func(acc list.List[int], i int, _ Item) list.List[int] {
+			return append(acc, i)
+		})
+
+		// assert
+		assertEqual(t, testCase.wantIndexes, gotIndexes, "wrong indexes!")
+	})
+}
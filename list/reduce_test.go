@@ -0,0 +1,97 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestReduceInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items   list.List[int64]
+		initial int64
+		wantSum int64
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:   nil,
+			initial: 5,
+			wantSum: 5,
+		},
+		"empty": {
+			items:   EmptyInt64List,
+			initial: 5,
+			wantSum: 5,
+		},
+		"all": {
+			items:   DefaultInt64List,
+			initial: 5,
+			wantSum: 5 + 21 + 12 + 34 + 87 + 52,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotSum := list.Reduce(testCase.items, testCase.initial, func(acc int64, item int64) int64 { return acc + item })
+
+		// assert
+		require.Equalf(t, testCase.wantSum, gotSum, "wrong sum!")
+	})
+}
+
+func TestReduceIndexStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[Item]
+		wantItems list.DeepList[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			wantItems: nil,
+		},
+		"empty": {
+			items:     EmptyItemList,
+			wantItems: nil,
+		},
+		"all": {
+			items:     DefaultItemList,
+			wantItems: list.NewDeep[int64](21, 13, 36, 90, 56),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.ReduceIndex(testCase.items, list.DeepList[int64](nil), func(acc list.DeepList[int64], i int, item Item) list.DeepList[int64] {
+			return append(acc, int64(i)+item.Value)
+		})
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
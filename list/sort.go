@@ -0,0 +1,40 @@
+package list
+
+import (
+	"sort"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+func Sort[V any, L ~[]V](l L, comparator util.Comparator[V]) {
+	sort.Slice(l, func(i, j int) bool { return comparator(l[i], l[j]) < 0 })
+}
+
+func SortStable[V any, L ~[]V](l L, comparator util.Comparator[V]) {
+	sort.SliceStable(l, func(i, j int) bool { return comparator(l[i], l[j]) < 0 })
+}
+
+func SortFunc[V any, L ~[]V](l L, less util.BiPredicate[V, V]) {
+	sort.Slice(l, func(i, j int) bool { return less(l[i], l[j]) })
+}
+
+func SortBy[V any, K util.Ordered, L ~[]V](l L, key util.Transformer[V, K]) {
+	SortStable(l, util.CompareBy(key))
+}
+
+func SortOrdered[V util.Ordered, L ~[]V](l L) {
+	Sort(l, util.Compare[V])
+}
+
+func IsSorted[V any, L ~[]V](l L, comparator util.Comparator[V]) bool {
+	for i := 1; i < len(l); i++ {
+		if comparator(l[i-1], l[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func IsSortedOrdered[V util.Ordered, L ~[]V](l L) bool {
+	return IsSorted(l, util.Compare[V])
+}
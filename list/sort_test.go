@@ -0,0 +1,195 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestSortInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      list.List[int64]
+		comparator util.Comparator[int64]
+		wantItems  list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			comparator: util.Compare[int64],
+			wantItems:  nil,
+		},
+		"empty": {
+			items:      EmptyInt64List,
+			comparator: util.Compare[int64],
+			wantItems:  EmptyInt64List,
+		},
+		"ascending": {
+			items:      DefaultInt64List,
+			comparator: util.Compare[int64],
+			wantItems:  list.New[int64](12, 21, 34, 52, 87),
+		},
+		"descending": {
+			items:      DefaultInt64List,
+			comparator: util.Reverse(util.Compare[int64]),
+			wantItems:  list.New[int64](87, 52, 34, 21, 12),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Copy(testCase.items)
+		list.Sort(gotItems, testCase.comparator)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+		require.True(t, list.IsSorted(gotItems, testCase.comparator), "not sorted!")
+	})
+}
+
+func TestSortStableStruct(t *testing.T) {
+
+	type Event struct {
+		Priority int
+		Name     string
+	}
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[Event]
+		wantItems list.List[Event]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			wantItems: nil,
+		},
+		"keep-order-of-equals": {
+			items: list.New(
+				Event{Priority: 2, Name: "a"},
+				Event{Priority: 1, Name: "b"},
+				Event{Priority: 2, Name: "c"},
+				Event{Priority: 1, Name: "d"},
+				Event{Priority: 0, Name: "e"},
+			),
+			wantItems: list.New(
+				Event{Priority: 0, Name: "e"},
+				Event{Priority: 1, Name: "b"},
+				Event{Priority: 1, Name: "d"},
+				Event{Priority: 2, Name: "a"},
+				Event{Priority: 2, Name: "c"},
+			),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotStable := list.Copy(testCase.items)
+		list.SortStable(gotStable, func(left Event, right Event) int { return left.Priority - right.Priority })
+		gotBy := list.Copy(testCase.items)
+		list.SortBy(gotBy, func(e Event) int { return e.Priority })
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotStable, "wrong items!")
+		assertEqual(t, testCase.wantItems, gotBy, "wrong items by key!")
+	})
+}
+
+func TestSortFuncStructPointer(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[*Item]
+		less      util.BiPredicate[*Item, *Item]
+		wantItems list.List[*Item]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			less:      func(left *Item, right *Item) bool { return left.Value < right.Value },
+			wantItems: nil,
+		},
+		"empty": {
+			items:     EmptyItemPointerList,
+			less:      func(left *Item, right *Item) bool { return left.Value < right.Value },
+			wantItems: EmptyItemPointerList,
+		},
+		"ascending": {
+			items: DefaultItemPointerList,
+			less:  func(left *Item, right *Item) bool { return left.Value < right.Value },
+			wantItems: list.New(
+				&Item{Value: 12},
+				&Item{Value: 21},
+				&Item{Value: 34},
+				&Item{Value: 52},
+				&Item{Value: 87},
+			),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Copy(testCase.items)
+		list.SortFunc(gotItems, testCase.less)
+
+		// assert
+		assertDeepEqual(t, testCase.wantItems, gotItems, "wrong items!")
+		require.True(t, list.IsSorted(gotItems, util.CompareWithLess(testCase.less)), "not sorted!")
+	})
+}
+
+func TestSortMethods(t *testing.T) {
+
+	// list
+
+	l := list.New[int64](3, 1, 2)
+	require.False(t, l.IsSorted(util.Compare[int64]), "list sorted before sort")
+	l.Sort(util.Compare[int64])
+	assertEqual(t, list.New[int64](1, 2, 3), l, "wrong sorted list")
+	require.True(t, list.IsSortedOrdered(l), "list not sorted after sort")
+
+	// deep list
+
+	d := list.NewDeep(&Item{Value: 3}, &Item{Value: 1}, &Item{Value: 2})
+	d.SortStable(util.CompareBy(func(i *Item) int64 { return i.Value }))
+	assertDeepEqual(t, list.NewDeep(&Item{Value: 1}, &Item{Value: 2}, &Item{Value: 3}), d, "wrong sorted deep list")
+
+	// ordered
+
+	o := list.New("b", "c", "a")
+	list.SortOrdered(o)
+	assertEqual(t, list.New("a", "b", "c"), o, "wrong sorted ordered list")
+}
@@ -0,0 +1,11 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func StableKeepIf[V any, L ~[]V](l *L, predicate util.Predicate[V]) bool {
+	return StableRemoveIf(l, util.Not(predicate))
+}
+
+func StableKeepIfIndex[V any, L ~[]V](l *L, predicate util.BiPredicate[int, V]) bool {
+	return StableRemoveIfIndex(l, util.BiNot(predicate))
+}
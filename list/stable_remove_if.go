@@ -0,0 +1,36 @@
+package list
+
+import "github.com/gvaligiani/al.go/util"
+
+func StableRemoveIf[V any, L ~[]V](l *L, predicate util.Predicate[V]) bool {
+	return StableRemoveIfIndex(l, util.TestOnSecondArg[int](predicate))
+}
+
+func StableRemoveIfIndex[V any, L ~[]V](l *L, predicate util.BiPredicate[int, V]) bool {
+	if l == nil || len(*l) == 0 {
+		return false
+	}
+	// note:
+	//  - this method keeps the original order of the list
+	//  - kept elements are compacted in place in a single pass
+	size := len(*l)
+	indexKept := 0
+	for index := 0; index < size; index++ {
+		value := (*l)[index]
+		if predicate(index, value) {
+			continue
+		}
+		if indexKept != index {
+			(*l)[indexKept] = value
+		}
+		indexKept++
+	}
+	// zero the freed tail so that removed elements can be garbage collected
+	var empty V
+	for index := indexKept; index < size; index++ {
+		(*l)[index] = empty
+	}
+	// reduce the list to the right size
+	(*l) = (*l)[:indexKept]
+	return indexKept < size
+}
@@ -0,0 +1,262 @@
+package list_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableCheckIndexes(t *testing.T) {
+	rand.Seed(time.Now().UnixMicro())
+	type Obj struct {
+		index int
+		value int
+	}
+	objs := list.List[Obj]{}
+	for i := 0; i < rand.Intn(100); i++ {
+		objs.Add(Obj{index: i, value: rand.Intn(10)})
+	}
+	backing := objs[:len(objs):len(objs)]
+	objs.StableRemoveIfIndex(func(i int, o Obj) bool {
+		require.Equal(t, i, o.index, "wrong index")
+		return o.value%2 == 1
+	})
+	for i := 1; i < len(objs); i++ {
+		require.Less(t, objs[i-1].index, objs[i].index, "wrong order")
+	}
+	for _, o := range backing[len(objs):] {
+		require.Equal(t, Obj{}, o, "freed tail not zeroed")
+	}
+}
+
+func TestStableRemoveIfInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       list.List[int64]
+		predicate   util.Predicate[int64]
+		wantUpdated bool
+		wantItems   list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			predicate:   func(i int64) bool { return i%2 == 0 },
+			wantUpdated: false,
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyInt64List,
+			predicate:   func(i int64) bool { return i%2 == 0 },
+			wantUpdated: false,
+			wantItems:   EmptyInt64List,
+		},
+		"remove-none": {
+			items:       DefaultInt64List,
+			predicate:   func(i int64) bool { return false },
+			wantUpdated: false,
+			wantItems:   DefaultInt64List,
+		},
+		"remove-odd": {
+			items:       DefaultInt64List,
+			predicate:   func(i int64) bool { return i%2 == 0 },
+			wantUpdated: true,
+			wantItems: list.New[int64](
+				21,
+				87,
+			),
+		},
+		"remove-even": {
+			items:       DefaultInt64List,
+			predicate:   func(i int64) bool { return i%2 == 1 },
+			wantUpdated: true,
+			wantItems: list.New[int64]( // order kept
+				12,
+				34,
+				52,
+			),
+		},
+		"remove-all": {
+			items:       DefaultInt64List,
+			predicate:   func(i int64) bool { return true },
+			wantUpdated: true,
+			wantItems:   EmptyInt64List,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Copy(testCase.items)
+		gotUpdated := list.StableRemoveIf(&gotItems, testCase.predicate)
+
+		// assert
+		require.Equal(t, testCase.wantUpdated, gotUpdated, "wrong updated!")
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestStableRemoveIfStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       list.List[Item]
+		predicate   util.Predicate[Item]
+		wantUpdated bool
+		wantItems   list.List[Item]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			predicate:   func(item Item) bool { return item.Value%2 == 0 },
+			wantUpdated: false,
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyItemList,
+			predicate:   func(item Item) bool { return item.Value%2 == 0 },
+			wantUpdated: false,
+			wantItems:   EmptyItemList,
+		},
+		"remove-none": {
+			items:       DefaultItemList,
+			predicate:   func(item Item) bool { return false },
+			wantUpdated: false,
+			wantItems:   DefaultItemList,
+		},
+		"remove-odd": {
+			items:       DefaultItemList,
+			predicate:   func(item Item) bool { return item.Value%2 == 0 },
+			wantUpdated: true,
+			wantItems: list.List[Item]{
+				Item{Value: 21},
+				Item{Value: 87},
+			},
+		},
+		"remove-even": {
+			items:       DefaultItemList,
+			predicate:   func(item Item) bool { return item.Value%2 == 1 },
+			wantUpdated: true,
+			wantItems: list.List[Item]{ // order kept
+				Item{Value: 12},
+				Item{Value: 34},
+				Item{Value: 52},
+			},
+		},
+		"remove-all": {
+			items:       DefaultItemList,
+			predicate:   func(item Item) bool { return true },
+			wantUpdated: true,
+			wantItems:   EmptyItemList,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Copy(testCase.items)
+		gotUpdated := list.StableRemoveIf(&gotItems, testCase.predicate)
+
+		// assert
+		require.Equal(t, testCase.wantUpdated, gotUpdated, "wrong updated!")
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestStableRemoveIfStructPointer(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       list.List[*Item]
+		predicate   util.Predicate[*Item]
+		wantUpdated bool
+		wantItems   list.List[*Item]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			predicate:   func(item *Item) bool { return item.Value%2 == 0 },
+			wantUpdated: false,
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyItemPointerList,
+			predicate:   func(item *Item) bool { return item.Value%2 == 0 },
+			wantUpdated: false,
+			wantItems:   EmptyItemPointerList,
+		},
+		"remove-none": {
+			items:       DefaultItemPointerList,
+			predicate:   func(item *Item) bool { return false },
+			wantUpdated: false,
+			wantItems:   DefaultItemPointerList,
+		},
+		"remove-odd": {
+			items:       DefaultItemPointerList,
+			predicate:   func(item *Item) bool { return item.Value%2 == 0 },
+			wantUpdated: true,
+			wantItems: list.List[*Item]{
+				&Item{Value: 21},
+				&Item{Value: 87},
+			},
+		},
+		"remove-even": {
+			items:       DefaultItemPointerList,
+			predicate:   func(item *Item) bool { return item.Value%2 == 1 },
+			wantUpdated: true,
+			wantItems: list.List[*Item]{ // order kept
+				&Item{Value: 12},
+				&Item{Value: 34},
+				&Item{Value: 52},
+			},
+		},
+		"remove-all": {
+			items:       DefaultItemPointerList,
+			predicate:   func(item *Item) bool { return true },
+			wantUpdated: true,
+			wantItems:   EmptyItemPointerList,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := list.Copy(testCase.items)
+		gotUpdated := list.StableRemoveIf(&gotItems, testCase.predicate)
+
+		// assert
+		require.Equal(t, testCase.wantUpdated, gotUpdated, "wrong updated!")
+		assertDeepEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
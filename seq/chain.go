@@ -0,0 +1,18 @@
+package seq
+
+func Chain[V any](seqs ...Seq[V]) Seq[V] {
+	return func(yield func(V) bool) {
+		for _, s := range seqs {
+			stopped := false
+			s(func(v V) bool {
+				if !yield(v) {
+					stopped = true
+				}
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
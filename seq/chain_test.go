@@ -0,0 +1,18 @@
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestChain(t *testing.T) {
+
+	s := seq.Chain(seq.Of[int64](1, 2), seq.Empty[int64](), seq.Of[int64](3))
+	require.Equal(t, list.New[int64](1, 2, 3), seq.ToList(s), "wrong items!")
+	require.Equal(t, list.New[int64](1, 2, 3, 0, 1), seq.ToList(s.Chain(Naturals()).Take(5)), "wrong items with infinite!")
+	require.Equal(t, list.New[int64](1, 2), seq.ToList(s.Take(2)), "wrong items after early stop!")
+}
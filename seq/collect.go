@@ -0,0 +1,49 @@
+package seq
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/set"
+)
+
+func ToList[V comparable](s Seq[V]) list.List[V] {
+	l := list.List[V]{}
+	Each(s, func(v V) { l = append(l, v) })
+	return l
+}
+
+func ToDeepList[V any](s Seq[V]) list.DeepList[V] {
+	l := list.DeepList[V]{}
+	Each(s, func(v V) { l = append(l, v) })
+	return l
+}
+
+func ToSet[V comparable](s Seq[V]) set.Set[V] {
+	result := set.Set[V]{}
+	Each(s, func(v V) { result[v] = struct{}{} })
+	return result
+}
+
+func ToDeepSet[V any](s Seq[V]) *set.DeepSet[V] {
+	result := set.NewDeep[V]()
+	Each(s, func(v V) { result.Add(v) })
+	return result
+}
+
+func ToDict[K comparable, V comparable](s Seq2[K, V]) dict.Dict[K, V] {
+	d := dict.Dict[K, V]{}
+	Each2(s, func(k K, v V) { d[k] = v })
+	return d
+}
+
+func ToDeepDict[K comparable, V any](s Seq2[K, V]) dict.DeepDict[K, V] {
+	d := dict.DeepDict[K, V]{}
+	Each2(s, func(k K, v V) { d[k] = v })
+	return d
+}
+
+func ToOrderedDict[K comparable, V any](s Seq2[K, V]) *dict.OrderedDict[K, V] {
+	d := dict.NewOrdered[K, V]()
+	Each2(s, func(k K, v V) { d.Add(k, v) })
+	return d
+}
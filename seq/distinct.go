@@ -0,0 +1,14 @@
+package seq
+
+func Distinct[V comparable](s Seq[V]) Seq[V] {
+	return func(yield func(V) bool) {
+		seen := map[V]struct{}{}
+		s(func(v V) bool {
+			if _, found := seen[v]; found {
+				return true
+			}
+			seen[v] = struct{}{}
+			return yield(v)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestDistinct(t *testing.T) {
+
+	s := seq.Distinct(seq.Of[int64](1, 2, 1, 3, 2, 4))
+	require.Equal(t, list.New[int64](1, 2, 3, 4), seq.ToList(s), "wrong items!")
+
+	mod := seq.Distinct(seq.Map(Naturals(), func(i int64) int64 { return i % 3 }))
+	require.Equal(t, list.New[int64](0, 1, 2), seq.ToList(mod.Take(3)), "wrong items with infinite!")
+}
@@ -0,0 +1,19 @@
+package seq
+
+import "github.com/gvaligiani/al.go/util"
+
+func Filter[V any](s Seq[V], predicate util.Predicate[V]) Seq[V] {
+	return func(yield func(V) bool) {
+		s(func(v V) bool {
+			return !predicate(v) || yield(v)
+		})
+	}
+}
+
+func Filter2[K any, V any](s Seq2[K, V], predicate util.BiPredicate[K, V]) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s(func(k K, v V) bool {
+			return !predicate(k, v) || yield(k, v)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package seq_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		predicate util.Predicate[int64]
+		wantItems list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			predicate: func(i int64) bool { return i%2 == 0 },
+			wantItems: EmptyInt64List,
+		},
+		"empty": {
+			items:     EmptyInt64List,
+			predicate: func(i int64) bool { return i%2 == 0 },
+			wantItems: EmptyInt64List,
+		},
+		"filter-none": {
+			items:     DefaultInt64List,
+			predicate: func(i int64) bool { return false },
+			wantItems: EmptyInt64List,
+		},
+		"filter-odd": {
+			items:     DefaultInt64List,
+			predicate: func(i int64) bool { return i%2 == 0 },
+			wantItems: list.New[int64](12, 34, 52),
+		},
+		"filter-all": {
+			items:     DefaultInt64List,
+			predicate: func(i int64) bool { return true },
+			wantItems: DefaultInt64List,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := seq.ToList(seq.FromList(testCase.items).Filter(testCase.predicate))
+
+		// assert
+		require.Equal(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestFilterIsLazy(t *testing.T) {
+
+	calls := 0
+	s := seq.Filter(Naturals(), func(i int64) bool {
+		calls++
+		return i%2 == 0
+	})
+	require.Equal(t, 0, calls, "filter called before terminal operation")
+
+	require.Equal(t, list.New[int64](0, 2, 4), seq.ToList(s.Take(3)), "wrong items!")
+	require.Equal(t, 5, calls, "filter called on too many items")
+}
+
+func TestFilter2(t *testing.T) {
+
+	s := seq.Filter2(seq.FromListIndex(DefaultInt64List), func(i int, _ int64) bool { return i%2 == 0 })
+	require.Equal(t, list.New[int64](21, 34, 52), seq.ToList(seq.Values(s)), "wrong items!")
+}
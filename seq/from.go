@@ -0,0 +1,92 @@
+package seq
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/set"
+)
+
+// list
+
+func FromList[V any, L ~[]V](l L) Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range l {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func FromListIndex[V any, L ~[]V](l L) Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		for i, v := range l {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// dict
+
+func FromDict[K comparable, V any, D ~map[K]V](d D) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range d {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func FromDictKeys[K comparable, V any, D ~map[K]V](d D) Seq[K] {
+	return Keys(FromDict(d))
+}
+
+func FromDictValues[K comparable, V any, D ~map[K]V](d D) Seq[V] {
+	return Values(FromDict(d))
+}
+
+func FromOrderedDict[K comparable, V any](d *dict.OrderedDict[K, V]) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		d.FindIfKey(func(k K, v V) bool { return !yield(k, v) })
+	}
+}
+
+func FromSortedDict[K any, V any](d *dict.SortedDict[K, V]) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		d.FindIfKey(func(k K, v V) bool { return !yield(k, v) })
+	}
+}
+
+// set
+
+func FromSet[V comparable, S ~map[V]struct{}](s S) Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func FromDeepSet[V any](s *set.DeepSet[V]) Seq[V] {
+	return func(yield func(V) bool) {
+		s.EachWhile(yield)
+	}
+}
+
+// seq2 <-> seq
+
+func Keys[K any, V any](s Seq2[K, V]) Seq[K] {
+	return func(yield func(K) bool) {
+		s(func(k K, _ V) bool { return yield(k) })
+	}
+}
+
+func Values[K any, V any](s Seq2[K, V]) Seq[V] {
+	return func(yield func(V) bool) {
+		s(func(_ K, v V) bool { return yield(v) })
+	}
+}
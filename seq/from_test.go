@@ -0,0 +1,46 @@
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+	"github.com/gvaligiani/al.go/set"
+)
+
+func TestFrom(t *testing.T) {
+
+	// list
+
+	require.Equal(t, DefaultInt64List, seq.ToList(seq.FromList(DefaultInt64List)), "from list")
+	require.Equal(t, list.NewDeep(&Item{Value: 1}), seq.ToDeepList(seq.FromList(list.NewDeep(&Item{Value: 1}))), "from deep list")
+
+	// dict
+
+	d := dict.Dict[string, int64]{"a": 1, "b": 2}
+	require.Equal(t, d, seq.ToDict(seq.FromDict(d)), "from dict")
+	require.Equal(t, set.New("a", "b"), seq.ToSet(seq.FromDictKeys(d)), "from dict keys")
+	require.Equal(t, set.New[int64](1, 2), seq.ToSet(seq.FromDictValues(d)), "from dict values")
+
+	dd := dict.DeepDict[string, []int]{"a": {1}}
+	require.Equal(t, dd, seq.ToDeepDict(seq.FromDict(dd)), "from deep dict")
+
+	od := dict.NewOrdered[string, int]().With("b", 2).With("a", 1).With("c", 3)
+	require.Equal(t, list.New("b", "a"), seq.ToList(seq.Keys(seq.FromOrderedDict(od)).Take(2)), "from ordered dict")
+	require.Equal(t, od.Keys(), seq.ToOrderedDict(seq.FromOrderedDict(od)).Keys(), "to ordered dict")
+
+	sd := dict.NewSortedOrdered[string, int]().With("b", 2).With("a", 1).With("c", 3)
+	require.Equal(t, list.New("a", "b"), seq.ToList(seq.Keys(seq.FromSortedDict(sd)).Take(2)), "from sorted dict")
+
+	// set
+
+	s := set.New[int64](1, 2, 3)
+	require.Equal(t, s, seq.ToSet(seq.FromSet(s)), "from set")
+
+	ds := set.NewDeep([]int{1}, []int{2})
+	require.True(t, ds.Equal(seq.ToDeepSet(seq.FromDeepSet(ds))), "from deep set")
+	require.Equal(t, 1, seq.FromDeepSet(ds).Take(1).Count(), "from deep set with early stop")
+}
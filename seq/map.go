@@ -0,0 +1,15 @@
+package seq
+
+import "github.com/gvaligiani/al.go/util"
+
+func Map[V any, O any](s Seq[V], transformer util.Transformer[V, O]) Seq[O] {
+	return func(yield func(O) bool) {
+		s(func(v V) bool { return yield(transformer(v)) })
+	}
+}
+
+func Map2[K any, V any, O any](s Seq2[K, V], transformer util.BiTransformer[K, V, O]) Seq2[K, O] {
+	return func(yield func(K, O) bool) {
+		s(func(k K, v V) bool { return yield(k, transformer(k, v)) })
+	}
+}
@@ -0,0 +1,28 @@
+package seq_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestMap(t *testing.T) {
+
+	s := seq.Map(seq.FromList(DefaultInt64List), func(i int64) string { return fmt.Sprint(i) })
+	require.Equal(t, list.New("21", "12", "34", "87", "52"), seq.ToList(s), "wrong items!")
+
+	s = seq.Map(seq.FromList(EmptyInt64List), func(i int64) string { return fmt.Sprint(i) })
+	require.Equal(t, list.List[string]{}, seq.ToList(s), "wrong empty items!")
+}
+
+func TestMap2(t *testing.T) {
+
+	d := dict.Dict[string, int64]{"a": 1, "b": 2}
+	s := seq.Map2(seq.FromDict(d), func(k string, v int64) string { return fmt.Sprintf("%s%d", k, v) })
+	require.Equal(t, dict.Dict[string, string]{"a": "a1", "b": "b2"}, seq.ToDict(s), "wrong items!")
+}
@@ -0,0 +1,43 @@
+//go:build !go1.23
+
+package seq
+
+import "sync"
+
+// pull turns a push sequence into a pull iterator, driving the sequence from a goroutine
+//
+// note: stop must be called to release the goroutine when the iterator is not exhausted
+func pull[V any](s Seq[V]) (func() (V, bool), func()) {
+	values := make(chan V)
+	done := make(chan struct{})
+	go func() {
+		defer close(values)
+		s(func(v V) bool {
+			select {
+			case values <- v:
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+	var once sync.Once
+	next := func() (V, bool) {
+		select {
+		case v, ok := <-values:
+			return v, ok
+		case <-done:
+			var noValue V
+			return noValue, false
+		}
+	}
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			// wait for the goroutine to complete
+			for range values {
+			}
+		})
+	}
+	return next, stop
+}
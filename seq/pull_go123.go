@@ -0,0 +1,9 @@
+//go:build go1.23
+
+package seq
+
+import "iter"
+
+func pull[V any](s Seq[V]) (func() (V, bool), func()) {
+	return iter.Pull(iter.Seq[V](s))
+}
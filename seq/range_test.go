@@ -0,0 +1,35 @@
+//go:build go1.23
+
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestRangeOverFunc(t *testing.T) {
+
+	var sum int64
+	for i := range seq.FromList(DefaultInt64List).Filter(func(i int64) bool { return i%2 == 0 }) {
+		sum += i
+	}
+	require.Equal(t, int64(12+34+52), sum, "sum")
+
+	keys := 0
+	for k, v := range seq.FromDict(dict.Dict[string, int]{"a": 1, "b": 2}) {
+		require.NotEmpty(t, k, "key")
+		require.NotZero(t, v, "value")
+		keys++
+	}
+	require.Equal(t, 2, keys, "keys")
+
+	for i := range Naturals() {
+		if i == 3 {
+			break
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package seq provides lazy sequences over the collections of the library
+//
+// note:
+//   - a sequence does nothing until it is consumed by a terminal operation
+//   - Seq and Seq2 have the same underlying types as iter.Seq and iter.Seq2,
+//     so they can be ranged over with a go1.23+ toolchain
+package seq
+
+import (
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/util"
+)
+
+// alias
+
+type Seq[V any] func(yield func(V) bool)
+type Seq2[K any, V any] func(yield func(K, V) bool)
+
+// builder
+
+func Of[V any](values ...V) Seq[V] {
+	return FromList(values)
+}
+
+func Empty[V any]() Seq[V] {
+	return func(yield func(V) bool) {}
+}
+
+// lazy
+
+func (s Seq[V]) Filter(predicate util.Predicate[V]) Seq[V] {
+	return Filter(s, predicate)
+}
+
+func (s Seq[V]) Take(n int) Seq[V] {
+	return Take(s, n)
+}
+
+func (s Seq[V]) TakeWhile(predicate util.Predicate[V]) Seq[V] {
+	return TakeWhile(s, predicate)
+}
+
+func (s Seq[V]) Skip(n int) Seq[V] {
+	return Skip(s, n)
+}
+
+func (s Seq[V]) Chain(others ...Seq[V]) Seq[V] {
+	return Chain(append([]Seq[V]{s}, others...)...)
+}
+
+// terminal
+
+func (s Seq[V]) Each(consumer util.Consumer[V]) {
+	Each(s, consumer)
+}
+
+func (s Seq[V]) Count() int {
+	return Count(s)
+}
+
+func (s Seq[V]) First() (V, bool) {
+	return First(s)
+}
+
+func (s Seq[V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	return FindIf(s, predicate)
+}
+
+func (s Seq[V]) AllOf(predicate util.Predicate[V]) bool {
+	return AllOf(s, predicate)
+}
+
+func (s Seq[V]) AnyOf(predicate util.Predicate[V]) bool {
+	return AnyOf(s, predicate)
+}
+
+func (s Seq[V]) ToDeepList() list.DeepList[V] {
+	return ToDeepList(s)
+}
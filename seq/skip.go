@@ -0,0 +1,29 @@
+package seq
+
+import "github.com/gvaligiani/al.go/util"
+
+func Skip[V any](s Seq[V], n int) Seq[V] {
+	return func(yield func(V) bool) {
+		count := 0
+		s(func(v V) bool {
+			if count < n {
+				count++
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+func SkipWhile[V any](s Seq[V], predicate util.Predicate[V]) Seq[V] {
+	return func(yield func(V) bool) {
+		skipping := true
+		s(func(v V) bool {
+			if skipping && predicate(v) {
+				return true
+			}
+			skipping = false
+			return yield(v)
+		})
+	}
+}
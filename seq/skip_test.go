@@ -0,0 +1,23 @@
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestSkip(t *testing.T) {
+
+	require.Equal(t, list.New[int64](34, 87, 52), seq.ToList(seq.FromList(DefaultInt64List).Skip(2)), "skip 2")
+	require.Equal(t, EmptyInt64List, seq.ToList(seq.FromList(DefaultInt64List).Skip(10)), "skip 10")
+	require.Equal(t, list.New[int64](5, 6), seq.ToList(Naturals().Skip(5).Take(2)), "skip naturals")
+}
+
+func TestSkipWhile(t *testing.T) {
+
+	s := seq.SkipWhile(seq.FromList(DefaultInt64List), func(i int64) bool { return i < 30 })
+	require.Equal(t, list.New[int64](34, 87, 52), seq.ToList(s), "wrong items!")
+}
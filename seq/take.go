@@ -0,0 +1,24 @@
+package seq
+
+import "github.com/gvaligiani/al.go/util"
+
+func Take[V any](s Seq[V], n int) Seq[V] {
+	return func(yield func(V) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		s(func(v V) bool {
+			count++
+			return yield(v) && count < n
+		})
+	}
+}
+
+func TakeWhile[V any](s Seq[V], predicate util.Predicate[V]) Seq[V] {
+	return func(yield func(V) bool) {
+		s(func(v V) bool {
+			return predicate(v) && yield(v)
+		})
+	}
+}
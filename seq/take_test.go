@@ -0,0 +1,68 @@
+package seq_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     list.List[int64]
+		n         int
+		wantItems list.List[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			n:         2,
+			wantItems: EmptyInt64List,
+		},
+		"take-none": {
+			items:     DefaultInt64List,
+			n:         0,
+			wantItems: EmptyInt64List,
+		},
+		"take-some": {
+			items:     DefaultInt64List,
+			n:         2,
+			wantItems: list.New[int64](21, 12),
+		},
+		"take-more": {
+			items:     DefaultInt64List,
+			n:         10,
+			wantItems: DefaultInt64List,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := seq.ToList(seq.Take(seq.FromList(testCase.items), testCase.n))
+
+		// assert
+		require.Equal(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+
+	s := Naturals().TakeWhile(func(i int64) bool { return i < 4 })
+	require.Equal(t, list.New[int64](0, 1, 2, 3), seq.ToList(s), "wrong items!")
+	require.Equal(t, list.New[int64](0, 1), seq.ToList(s.Take(2)), "wrong items after take!")
+}
@@ -0,0 +1,62 @@
+package seq
+
+import "github.com/gvaligiani/al.go/util"
+
+func Each[V any](s Seq[V], consumer util.Consumer[V]) {
+	s(func(v V) bool {
+		consumer(v)
+		return true
+	})
+}
+
+func Each2[K any, V any](s Seq2[K, V], consumer util.BiConsumer[K, V]) {
+	s(func(k K, v V) bool {
+		consumer(k, v)
+		return true
+	})
+}
+
+func Count[V any](s Seq[V]) int {
+	count := 0
+	s(func(V) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func First[V any](s Seq[V]) (V, bool) {
+	return FindIf(s, util.True[V]())
+}
+
+func FindIf[V any](s Seq[V], predicate util.Predicate[V]) (V, bool) {
+	var value V
+	found := false
+	s(func(v V) bool {
+		if predicate(v) {
+			value = v
+			found = true
+		}
+		return !found
+	})
+	return value, found
+}
+
+func AllOf[V any](s Seq[V], predicate util.Predicate[V]) bool {
+	_, found := FindIf(s, util.Not(predicate))
+	return !found
+}
+
+func AnyOf[V any](s Seq[V], predicate util.Predicate[V]) bool {
+	_, found := FindIf(s, predicate)
+	return found
+}
+
+func Reduce[V any, A any](s Seq[V], initial A, reducer util.BiTransformer[A, V, A]) A {
+	acc := initial
+	s(func(v V) bool {
+		acc = reducer(acc, v)
+		return true
+	})
+	return acc
+}
@@ -0,0 +1,35 @@
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestTerminal(t *testing.T) {
+
+	s := seq.FromList(DefaultInt64List)
+
+	require.Equal(t, 5, s.Count(), "count")
+	require.Equal(t, int64(21+12+34+87+52), seq.Reduce(s, int64(0), func(acc int64, i int64) int64 { return acc + i }), "reduce")
+
+	first, found := s.First()
+	require.Equal(t, int64(21), first, "first")
+	require.True(t, found, "found first")
+	_, found = seq.Empty[int64]().First()
+	require.False(t, found, "found first in empty")
+
+	item, found := Naturals().FindIf(func(i int64) bool { return i > 10 })
+	require.Equal(t, int64(11), item, "find if on infinite")
+	require.True(t, found, "found on infinite")
+
+	require.True(t, s.AllOf(func(i int64) bool { return i > 10 }), "all_of")
+	require.False(t, s.AnyOf(func(i int64) bool { return i > 100 }), "any_of")
+	require.True(t, Naturals().AnyOf(func(i int64) bool { return i > 100 }), "any_of on infinite")
+
+	var sum int64
+	s.Each(func(i int64) { sum += i })
+	require.Equal(t, int64(21+12+34+87+52), sum, "each")
+}
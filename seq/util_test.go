@@ -0,0 +1,34 @@
+package seq_test
+
+import (
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+// int64
+
+var (
+	EmptyInt64List   = list.List[int64]{}
+	DefaultInt64List = list.List[int64]{
+		21,
+		12,
+		34,
+		87,
+		52,
+	}
+)
+
+// Item
+
+type Item struct {
+	Value int64
+}
+
+// infinite
+
+func Naturals() seq.Seq[int64] {
+	return func(yield func(int64) bool) {
+		for i := int64(0); yield(i); i++ {
+		}
+	}
+}
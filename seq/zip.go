@@ -0,0 +1,16 @@
+package seq
+
+// Zip pairs the values of both sequences, and stops as soon as one of them is exhausted
+func Zip[A any, B any](left Seq[A], right Seq[B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := pull(right)
+		defer stop()
+		left(func(a A) bool {
+			b, ok := next()
+			if !ok {
+				return false
+			}
+			return yield(a, b)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package seq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/seq"
+)
+
+func TestZip(t *testing.T) {
+
+	// shortest wins
+
+	s := seq.Zip(seq.Of("a", "b", "c"), Naturals())
+	require.Equal(t, dict.Dict[string, int64]{"a": 0, "b": 1, "c": 2}, seq.ToDict(s), "wrong items!")
+
+	s = seq.Zip(seq.Of("a", "b", "c"), seq.Of[int64](1))
+	require.Equal(t, dict.Dict[string, int64]{"a": 1}, seq.ToDict(s), "wrong items with short right!")
+
+	// early stop
+
+	count := 0
+	s = seq.Zip(seq.Of("a", "b", "c"), Naturals())
+	s(func(string, int64) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count, "wrong count after early stop!")
+}
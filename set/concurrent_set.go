@@ -0,0 +1,167 @@
+package set
+
+import (
+	"sync"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// ConcurrentSet is a set safe for concurrent use, guarded by a read-write mutex
+//
+// note:
+//   - iterations and predicates run on a snapshot taken under the read lock,
+//     so consumers can modify the set without deadlocking
+//   - predicates of RemoveIf / KeepIf are called under the write lock, so they must not access the set
+//   - WithLock / WithRLock give access to the inner set for multi-step transactions
+type ConcurrentSet[V comparable] struct {
+	mutex sync.RWMutex
+	items Set[V]
+}
+
+// builder
+
+func NewConcurrent[V comparable](values ...V) *ConcurrentSet[V] {
+	return &ConcurrentSet[V]{items: New(values...)}
+}
+
+func (s *ConcurrentSet[V]) With(value V) *ConcurrentSet[V] {
+	s.Add(value)
+	return s
+}
+
+// getter
+
+func (s *ConcurrentSet[V]) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.items)
+}
+
+func (s *ConcurrentSet[V]) Values() []V {
+	return s.Snapshot().Values()
+}
+
+func (s *ConcurrentSet[V]) Snapshot() Set[V] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if s.items == nil {
+		return Set[V]{}
+	}
+	return s.items.Copy()
+}
+
+// state
+
+func (s *ConcurrentSet[V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *ConcurrentSet[V]) AllOf(predicate util.Predicate[V]) bool {
+	return s.Snapshot().AllOf(predicate)
+}
+
+func (s *ConcurrentSet[V]) AnyOf(predicate util.Predicate[V]) bool {
+	return s.Snapshot().AnyOf(predicate)
+}
+
+func (s *ConcurrentSet[V]) NoneOf(predicate util.Predicate[V]) bool {
+	return s.Snapshot().NoneOf(predicate)
+}
+
+// each
+
+func (s *ConcurrentSet[V]) Each(consumer util.Consumer[V]) {
+	s.Snapshot().Each(consumer)
+}
+
+func (s *ConcurrentSet[V]) EachWhile(consumer util.Predicate[V]) bool {
+	return s.Snapshot().EachWhile(consumer)
+}
+
+func (s *ConcurrentSet[V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return s.Snapshot().EachErr(consumer)
+}
+
+// find
+
+func (s *ConcurrentSet[V]) Find(value V) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.items.Find(value)
+}
+
+func (s *ConcurrentSet[V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	return s.Snapshot().FindIf(predicate)
+}
+
+func (s *ConcurrentSet[V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return s.Snapshot().FindIfNot(predicate)
+}
+
+// copy
+
+func (s *ConcurrentSet[V]) Copy() *ConcurrentSet[V] {
+	return &ConcurrentSet[V]{items: s.Snapshot()}
+}
+
+func (s *ConcurrentSet[V]) CopyIf(predicate util.Predicate[V]) *ConcurrentSet[V] {
+	return &ConcurrentSet[V]{items: s.Snapshot().CopyIf(predicate)}
+}
+
+func (s *ConcurrentSet[V]) CopyIfNot(predicate util.Predicate[V]) *ConcurrentSet[V] {
+	return &ConcurrentSet[V]{items: s.Snapshot().CopyIfNot(predicate)}
+}
+
+// modifier
+
+func (s *ConcurrentSet[V]) Add(value V) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.items == nil {
+		s.items = Set[V]{}
+	}
+	return s.items.Add(value)
+}
+
+func (s *ConcurrentSet[V]) Remove(value V) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.items.Remove(value)
+}
+
+func (s *ConcurrentSet[V]) Clear() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.items.Clear()
+}
+
+func (s *ConcurrentSet[V]) RemoveIf(predicate util.Predicate[V]) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.items.RemoveIf(predicate)
+}
+
+func (s *ConcurrentSet[V]) KeepIf(predicate util.Predicate[V]) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.items.KeepIf(predicate)
+}
+
+// transaction
+
+// WithLock runs the function with an exclusive access to the inner set
+func (s *ConcurrentSet[V]) WithLock(transaction func(inner *Set[V])) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.items == nil {
+		s.items = Set[V]{}
+	}
+	transaction(&s.items)
+}
+
+// WithRLock runs the function with a shared read-only access to the inner set
+func (s *ConcurrentSet[V]) WithRLock(transaction func(inner Set[V])) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	transaction(s.items)
+}
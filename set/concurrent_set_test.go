@@ -0,0 +1,107 @@
+package set_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/set"
+)
+
+func TestConcurrentSet(t *testing.T) {
+
+	// builder
+
+	s := set.NewConcurrent(21, 12).With(34)
+
+	// add / remove
+
+	require.True(t, s.Add(87), "add 87")
+	require.False(t, s.Add(87), "add 87 twice")
+	require.True(t, s.Remove(21), "remove 21")
+	require.False(t, s.Remove(21), "remove 21 twice")
+
+	// set{12,34,87}
+
+	// predicate
+
+	require.True(t, s.AllOf(func(v int) bool { return v > 10 }), "all_of")
+	require.True(t, s.AnyOf(func(v int) bool { return v == 34 }), "any_of")
+	require.True(t, s.NoneOf(func(v int) bool { return v > 100 }), "none_of")
+
+	// find
+
+	require.True(t, s.Find(12), "find 12")
+	require.False(t, s.Find(21), "find 21")
+	value, found := s.FindIf(func(v int) bool { return v%2 == 1 })
+	require.Equal(t, 87, value, "odd value")
+	require.True(t, found, "found odd")
+
+	// each can modify the set
+
+	s.Each(func(v int) { s.Add(v + 1) })
+	require.Equal(t, 6, s.Len(), "len after add in each")
+	s.RemoveIf(func(v int) bool { return v == 13 || v == 35 || v == 88 })
+
+	// copy
+
+	evens := s.CopyIf(func(v int) bool { return v%2 == 0 })
+	require.Equal(t, set.New(12, 34), evens.Snapshot(), "wrong evens")
+	require.True(t, evens.KeepIf(func(v int) bool { return v < 20 }), "keep if")
+	require.Equal(t, set.New(12), evens.Snapshot(), "wrong kept")
+	require.Equal(t, set.New(12, 34, 87), s.Snapshot(), "source of copy has been modified")
+
+	// transaction
+
+	s.WithLock(func(inner *set.Set[int]) {
+		if inner.Find(12) {
+			inner.Remove(12)
+			inner.Add(21)
+		}
+	})
+	s.WithRLock(func(inner set.Set[int]) {
+		require.Equal(t, set.New(21, 34, 87), inner, "after transaction")
+	})
+
+	// clear
+
+	require.False(t, s.IsEmpty(), "is_empty before clear")
+	require.True(t, s.Clear(), "clear")
+	require.True(t, s.IsEmpty(), "is_empty after clear")
+	require.False(t, s.Clear(), "clear twice")
+
+	// zero value
+
+	var zero set.ConcurrentSet[int]
+	require.True(t, zero.Add(1), "add to zero value")
+	require.Equal(t, 1, zero.Len(), "zero value len")
+}
+
+func TestConcurrentSetRace(t *testing.T) {
+
+	const goroutines = 16
+	const values = 1000
+
+	s := set.NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < values; i++ {
+				s.Add(g*values + i)
+				s.Find(i)
+				if i%100 == 0 {
+					s.AnyOf(func(v int) bool { return v < 0 })
+					s.WithLock(func(inner *set.Set[int]) { inner.Add(-g - 1) })
+					s.Remove(-g - 1)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	require.Equal(t, goroutines*values, s.Len(), "wrong len")
+	require.True(t, s.AllOf(func(v int) bool { return v >= 0 }), "transaction values removed")
+}
@@ -0,0 +1,10 @@
+package set
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func CountBy[V comparable, K comparable, S ~map[V]struct{}](s S, key util.Transformer[V, K]) dict.Dict[K, int] {
+	return dict.CountByKey(s, func(v V, _ struct{}) K { return key(v) })
+}
@@ -0,0 +1,106 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestCountByInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      set.Set[int64]
+		key        util.Transformer[int64, bool]
+		wantCounts dict.Dict[bool, int]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			key:        func(i int64) bool { return i%2 == 0 },
+			wantCounts: nil,
+		},
+		"empty": {
+			items:      EmptyInt64Set,
+			key:        func(i int64) bool { return i%2 == 0 },
+			wantCounts: dict.Dict[bool, int]{},
+		},
+		"even": {
+			items:      DefaultInt64Set,
+			key:        func(i int64) bool { return i%2 == 0 },
+			wantCounts: dict.Dict[bool, int]{true: 3, false: 2},
+		},
+		"single-key": {
+			items:      DefaultInt64Set,
+			key:        func(i int64) bool { return true },
+			wantCounts: dict.Dict[bool, int]{true: 5},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotCounts := set.CountBy(testCase.items, testCase.key)
+
+		// assert
+		require.Equal(t, testCase.wantCounts, gotCounts, "wrong counts!")
+	})
+}
+
+func TestCountByStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      set.Set[Item]
+		key        util.Transformer[Item, int64]
+		wantCounts dict.Dict[int64, int]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			key:        func(i Item) int64 { return i.Value % 3 },
+			wantCounts: nil,
+		},
+		"empty": {
+			items:      EmptyItemSet,
+			key:        func(i Item) int64 { return i.Value % 3 },
+			wantCounts: dict.Dict[int64, int]{},
+		},
+		"modulo-3": {
+			items:      DefaultItemSet,
+			key:        func(i Item) int64 { return i.Value % 3 },
+			wantCounts: dict.Dict[int64, int]{0: 3, 1: 2},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotCounts := set.CountBy(testCase.items, testCase.key)
+
+		// assert
+		require.Equal(t, testCase.wantCounts, gotCounts, "wrong counts!")
+	})
+}
@@ -0,0 +1,287 @@
+package set
+
+import "github.com/gvaligiani/al.go/util"
+
+// DeepSet is a set of any values, including non-comparable ones
+//
+// note:
+//   - values are bucketed by hash, then compared by equality inside a bucket
+//   - the hash function must be consistent with the equality: equal values must have the same hash
+//   - the zero value is an empty set using util.DeepHash and util.DeepEqual
+//   - values implementing util.Equaler but not util.Hasher all fall in the same bucket with util.DeepHash,
+//     so lookups are linear and building the set is quadratic: such types should also implement util.Hasher
+type DeepSet[V any] struct {
+	hash    util.HashFunc[V]
+	equal   util.BiPredicate[V, V]
+	buckets map[uint64][]V
+	size    int
+}
+
+// builder
+
+// NewDeep builds a set using util.DeepHash and util.DeepEqual
+//
+// note: a value type implementing util.Equaler should also implement util.Hasher, to keep lookups in constant time
+func NewDeep[V any](values ...V) *DeepSet[V] {
+	return NewDeepFn(util.DeepHash[V], util.DeepEqual[V], values...)
+}
+
+func NewDeepFn[V any](hash util.HashFunc[V], equal util.BiPredicate[V, V], values ...V) *DeepSet[V] {
+	s := &DeepSet[V]{hash: hash, equal: equal}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+func (s *DeepSet[V]) With(value V) *DeepSet[V] {
+	s.Add(value)
+	return s
+}
+
+// getter
+
+func (s *DeepSet[V]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+func (s *DeepSet[V]) Values() []V {
+	l := make([]V, 0, s.Len())
+	s.Each(func(v V) { l = append(l, v) })
+	return l
+}
+
+// state
+
+func (s *DeepSet[V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *DeepSet[V]) AllOf(predicate util.Predicate[V]) bool {
+	_, found := s.FindIfNot(predicate)
+	return !found
+}
+
+func (s *DeepSet[V]) AnyOf(predicate util.Predicate[V]) bool {
+	_, found := s.FindIf(predicate)
+	return found
+}
+
+func (s *DeepSet[V]) NoneOf(predicate util.Predicate[V]) bool {
+	return !s.AnyOf(predicate)
+}
+
+// each
+
+func (s *DeepSet[V]) Each(consumer util.Consumer[V]) {
+	s.EachWhile(func(v V) bool {
+		consumer(v)
+		return true
+	})
+}
+
+func (s *DeepSet[V]) EachWhile(consumer util.Predicate[V]) bool {
+	if s == nil {
+		return true
+	}
+	for _, bucket := range s.buckets {
+		for _, v := range bucket {
+			if !consumer(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s *DeepSet[V]) EachErr(consumer util.ErrConsumer[V]) error {
+	var err error
+	s.EachWhile(func(v V) bool {
+		err = consumer(v)
+		return err == nil
+	})
+	return err
+}
+
+// find
+
+func (s *DeepSet[V]) Find(value V) bool {
+	if s.Len() == 0 {
+		return false
+	}
+	_, index := s.locate(value)
+	return index >= 0
+}
+
+func (s *DeepSet[V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	var value V
+	found := !s.EachWhile(func(v V) bool {
+		if predicate(v) {
+			value = v
+			return false
+		}
+		return true
+	})
+	return value, found
+}
+
+func (s *DeepSet[V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return s.FindIf(util.Not(predicate))
+}
+
+// copy
+
+func (s *DeepSet[V]) Copy() *DeepSet[V] {
+	return s.CopyIf(util.True[V]())
+}
+
+func (s *DeepSet[V]) CopyIf(predicate util.Predicate[V]) *DeepSet[V] {
+	if s == nil {
+		return nil
+	}
+	copy := &DeepSet[V]{hash: s.hash, equal: s.equal}
+	for h, bucket := range s.buckets {
+		for _, v := range bucket {
+			if predicate(v) {
+				copy.insert(h, v)
+			}
+		}
+	}
+	return copy
+}
+
+func (s *DeepSet[V]) CopyIfNot(predicate util.Predicate[V]) *DeepSet[V] {
+	return s.CopyIf(util.Not(predicate))
+}
+
+// compare
+
+func (s *DeepSet[V]) Equal(other *DeepSet[V]) bool {
+	if s == nil && other == nil {
+		return true
+	}
+	if s == nil || other == nil {
+		return false
+	}
+	if s.size != other.size {
+		return false
+	}
+	return s.AllOf(other.Find)
+}
+
+// modifier
+
+func (s *DeepSet[V]) Add(value V) bool {
+	if s == nil {
+		return false
+	}
+	h, index := s.locate(value)
+	if index >= 0 {
+		return false
+	}
+	s.insert(h, value)
+	return true
+}
+
+func (s *DeepSet[V]) Remove(value V) bool {
+	if s.Len() == 0 {
+		return false
+	}
+	h, index := s.locate(value)
+	if index < 0 {
+		return false
+	}
+	s.delete(h, index)
+	return true
+}
+
+func (s *DeepSet[V]) Clear() bool {
+	if s.Len() == 0 {
+		return false
+	}
+	s.buckets = nil
+	s.size = 0
+	return true
+}
+
+func (s *DeepSet[V]) RemoveIf(predicate util.Predicate[V]) bool {
+	if s.Len() == 0 {
+		return false
+	}
+	size := s.size
+	for h, bucket := range s.buckets {
+		kept := bucket[:0]
+		for _, v := range bucket {
+			if !predicate(v) {
+				kept = append(kept, v)
+			}
+		}
+		// zero the freed tail of the bucket
+		var empty V
+		for i := len(kept); i < len(bucket); i++ {
+			bucket[i] = empty
+		}
+		s.size -= len(bucket) - len(kept)
+		if len(kept) == 0 {
+			delete(s.buckets, h)
+		} else {
+			s.buckets[h] = kept
+		}
+	}
+	return s.size < size
+}
+
+func (s *DeepSet[V]) KeepIf(predicate util.Predicate[V]) bool {
+	return s.RemoveIf(util.Not(predicate))
+}
+
+// internal
+
+func (s *DeepSet[V]) hashOf(value V) uint64 {
+	if s.hash == nil {
+		return util.DeepHash(value)
+	}
+	return s.hash(value)
+}
+
+func (s *DeepSet[V]) equalTo(left V, right V) bool {
+	if s.equal == nil {
+		return util.DeepEqual(left, right)
+	}
+	return s.equal(left, right)
+}
+
+func (s *DeepSet[V]) locate(value V) (uint64, int) {
+	h := s.hashOf(value)
+	for i, v := range s.buckets[h] {
+		if s.equalTo(v, value) {
+			return h, i
+		}
+	}
+	return h, -1
+}
+
+func (s *DeepSet[V]) insert(h uint64, value V) {
+	if s.buckets == nil {
+		s.buckets = map[uint64][]V{}
+	}
+	s.buckets[h] = append(s.buckets[h], value)
+	s.size++
+}
+
+func (s *DeepSet[V]) delete(h uint64, index int) {
+	bucket := s.buckets[h]
+	last := len(bucket) - 1
+	bucket[index] = bucket[last]
+	var empty V
+	bucket[last] = empty
+	if last == 0 {
+		delete(s.buckets, h)
+	} else {
+		s.buckets[h] = bucket[:last]
+	}
+	s.size--
+}
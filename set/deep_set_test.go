@@ -0,0 +1,146 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestDeepSet(t *testing.T) {
+
+	// builder
+
+	s := set.NewDeep(
+		[]int64{10},
+		[]int64{12, 1},
+		[]int64{10}, // duplicate
+	)
+	require.Equal(t, 2, s.Len(), "len after build")
+
+	// add
+
+	require.True(t, s.Add([]int64{17}), "add 17")
+	require.False(t, s.Add([]int64{17}), "add 17 twice")
+	require.True(t, s.Add([]int64{11}), "add 11")
+
+	// remove
+
+	require.True(t, s.Remove([]int64{10}), "remove 10")
+	require.False(t, s.Remove([]int64{10}), "remove 10 twice")
+	require.True(t, s.Remove([]int64{17}), "remove 17")
+
+	// set[[12 1] [11]]
+
+	// predicate
+
+	require.True(t, s.AllOf(func(i []int64) bool { return i[0] < 20 }), "all_of")
+	require.False(t, s.AllOf(func(i []int64) bool { return len(i) == 1 }), "all_of")
+	require.True(t, s.NoneOf(func(i []int64) bool { return i[0] < 10 }), "none_of")
+	require.True(t, s.AnyOf(func(i []int64) bool { return len(i) == 2 }), "any_of")
+
+	// find
+
+	require.True(t, s.Find([]int64{11}), "find 11")
+	require.True(t, s.Find([]int64{12, 1}), "find 12-1")
+	require.False(t, s.Find([]int64{12}), "find 12")
+
+	item, found := s.FindIf(func(i []int64) bool { return len(i) == 2 })
+	require.Equal(t, []int64{12, 1}, item, "item of len 2")
+	require.True(t, found, "found len 2")
+
+	item, found = s.FindIfNot(func(i []int64) bool { return len(i) == 2 })
+	require.Equal(t, []int64{11}, item, "item not of len 2")
+	require.True(t, found, "found not len 2")
+
+	// range
+
+	var sum int64
+	s.Each(func(i []int64) {
+		for _, v := range i {
+			sum += v
+		}
+	})
+	require.Equal(t, int64(12+1+11), sum, "sum")
+
+	// remove if
+
+	short := s.Copy()
+	updated := short.RemoveIf(func(i []int64) bool { return len(i) == 2 })
+	require.True(t, updated, "wrong updated!")
+	require.True(t, set.NewDeep([]int64{11}).Equal(short), "wrong short")
+
+	// keep if
+
+	long := s.Copy()
+	updated = long.KeepIf(func(i []int64) bool { return len(i) == 2 })
+	require.True(t, updated, "wrong updated!")
+	require.True(t, set.NewDeep([]int64{12, 1}).Equal(long), "wrong long")
+
+	// copy if
+
+	require.True(t, set.NewDeep([]int64{11}).Equal(s.CopyIfNot(func(i []int64) bool { return len(i) == 2 })), "wrong copy if not")
+
+	// check source of copy
+
+	require.True(t, set.NewDeep([]int64{11}, []int64{12, 1}).Equal(s), "source of copy has been modified")
+	require.False(t, set.NewDeep([]int64{11}).Equal(s), "equal to subset")
+
+	// clear
+
+	require.False(t, s.IsEmpty(), "is_empty before clear")
+	require.True(t, s.Clear(), "wrong updated!")
+	require.True(t, s.IsEmpty(), "is_empty after clear")
+	require.False(t, s.Clear(), "wrong updated!")
+}
+
+func TestDeepSetStruct(t *testing.T) {
+
+	type Node struct {
+		Name     string
+		Children map[string][]int
+		internal *Item
+	}
+
+	s := set.NewDeep(
+		Node{Name: "a", Children: map[string][]int{"x": {1, 2}, "y": {3}}, internal: &Item{Value: 1}},
+		Node{Name: "a", Children: map[string][]int{"y": {3}, "x": {1, 2}}, internal: &Item{Value: 1}}, // duplicate
+		Node{Name: "a", Children: map[string][]int{"x": {1, 2}, "y": {3}}, internal: &Item{Value: 2}},
+		Node{Name: "b"},
+	)
+	require.Equal(t, 3, s.Len(), "len")
+	require.True(t, s.Find(Node{Name: "b"}), "find b")
+	require.False(t, s.Find(Node{Name: "b", Children: map[string][]int{}}), "find b with empty children")
+}
+
+func TestDeepSetFn(t *testing.T) {
+
+	// case-insensitive set of strings, through a custom hash and equality
+
+	lower := func(s string) string {
+		b := []byte(s)
+		for i, c := range b {
+			if 'A' <= c && c <= 'Z' {
+				b[i] = c + 'a' - 'A'
+			}
+		}
+		return string(b)
+	}
+	s := set.NewDeepFn(
+		func(s string) uint64 { return util.DeepHash(lower(s)) },
+		func(left string, right string) bool { return lower(left) == lower(right) },
+		"Hello", "HELLO", "world",
+	)
+	require.Equal(t, 2, s.Len(), "len")
+	require.True(t, s.Find("hello"), "find hello")
+	require.True(t, s.Remove("WORLD"), "remove world")
+	require.Equal(t, []string{"Hello"}, s.Values(), "values")
+
+	// zero value
+
+	var zero set.DeepSet[[]int]
+	require.True(t, zero.Add([]int{1}), "add to zero")
+	require.True(t, zero.Find([]int{1}), "find in zero")
+}
@@ -0,0 +1,57 @@
+package set
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Delta lists the values only in the left set and only in the right set
+type Delta[V comparable] struct {
+	OnlyLeft  Set[V]
+	OnlyRight Set[V]
+}
+
+func Diff[V comparable, S ~map[V]struct{}](left S, right S) Delta[V] {
+	delta := Delta[V]{
+		OnlyLeft:  Set[V]{},
+		OnlyRight: Set[V]{},
+	}
+	for v := range left {
+		if _, found := right[v]; !found {
+			delta.OnlyLeft[v] = struct{}{}
+		}
+	}
+	for v := range right {
+		if _, found := left[v]; !found {
+			delta.OnlyRight[v] = struct{}{}
+		}
+	}
+	return delta
+}
+
+func (d Delta[V]) IsEmpty() bool {
+	return len(d.OnlyLeft) == 0 && len(d.OnlyRight) == 0
+}
+
+// String renders one line per value, sorted by value: "- value" for only left, "+ value" for only right
+func (d Delta[V]) String() string {
+	type line struct {
+		value string
+		text  string
+	}
+	lines := make([]line, 0, len(d.OnlyLeft)+len(d.OnlyRight))
+	for v := range d.OnlyLeft {
+		lines = append(lines, line{value: fmt.Sprint(v), text: fmt.Sprintf("- %v", v)})
+	}
+	for v := range d.OnlyRight {
+		lines = append(lines, line{value: fmt.Sprint(v), text: fmt.Sprintf("+ %v", v)})
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i].value < lines[j].value })
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(l.text)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
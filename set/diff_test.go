@@ -0,0 +1,61 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestDiffInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		left      set.Set[int64]
+		right     set.Set[int64]
+		wantDelta set.Delta[int64]
+		wantText  string
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			left:      nil,
+			right:     nil,
+			wantDelta: set.Delta[int64]{OnlyLeft: set.Set[int64]{}, OnlyRight: set.Set[int64]{}},
+			wantText:  "",
+		},
+		"equal": {
+			left:      DefaultInt64Set,
+			right:     DefaultInt64Set,
+			wantDelta: set.Delta[int64]{OnlyLeft: set.Set[int64]{}, OnlyRight: set.Set[int64]{}},
+			wantText:  "",
+		},
+		"with-other": {
+			left:      DefaultInt64Set,
+			right:     OtherInt64Set,
+			wantDelta: set.Delta[int64]{OnlyLeft: set.New[int64](34), OnlyRight: set.New[int64](69)},
+			wantText:  "- 34\n+ 69\n",
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		delta := set.Diff(testCase.left, testCase.right)
+
+		// assert
+		require.Equal(t, testCase.wantDelta, delta, "wrong delta!")
+		require.Equal(t, testCase.wantText == "", delta.IsEmpty(), "wrong is_empty!")
+		require.Equal(t, testCase.wantText, delta.String(), "wrong text!")
+	})
+}
@@ -0,0 +1,32 @@
+package set
+
+func Difference[V comparable, S ~map[V]struct{}](left S, right S) S {
+	if left == nil && right == nil {
+		return nil
+	}
+	difference := make(S, len(left))
+	for v := range left {
+		if _, found := right[v]; !found {
+			difference[v] = struct{}{}
+		}
+	}
+	return difference
+}
+
+func DifferenceWith[V comparable, S ~map[V]struct{}](s *S, other S) bool {
+	if s == nil || len(*s) == 0 || len(other) == 0 {
+		return false
+	}
+	size := len(*s)
+	if len(other) < size {
+		// iterate over the smaller operand
+		for v := range other {
+			delete(*s, v)
+		}
+		return len(*s) < size
+	}
+	return RemoveIf(s, func(v V) bool {
+		_, found := other[v]
+		return found
+	})
+}
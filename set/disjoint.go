@@ -0,0 +1,14 @@
+package set
+
+func IsDisjoint[V comparable, S ~map[V]struct{}](left S, right S) bool {
+	// iterate over the smaller operand
+	if len(left) > len(right) {
+		left, right = right, left
+	}
+	for v := range left {
+		if _, found := right[v]; found {
+			return false
+		}
+	}
+	return true
+}
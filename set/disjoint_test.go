@@ -0,0 +1,65 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestIsDisjointInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		left         set.Set[int64]
+		right        set.Set[int64]
+		wantDisjoint bool
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			left:         nil,
+			right:        nil,
+			wantDisjoint: true,
+		},
+		"empty": {
+			left:         EmptyInt64Set,
+			right:        DefaultInt64Set,
+			wantDisjoint: true,
+		},
+		"self": {
+			left:         DefaultInt64Set,
+			right:        DefaultInt64Set,
+			wantDisjoint: false,
+		},
+		"other": {
+			left:         DefaultInt64Set,
+			right:        OtherInt64Set,
+			wantDisjoint: false,
+		},
+		"disjoint": {
+			left:         DefaultInt64Set,
+			right:        set.New[int64](1, 2, 3),
+			wantDisjoint: true,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotDisjoint := set.IsDisjoint(testCase.left, testCase.right)
+
+		// assert
+		require.Equal(t, testCase.wantDisjoint, gotDisjoint, "wrong disjoint!")
+	})
+}
@@ -0,0 +1,10 @@
+package set
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func EachErr[V comparable, S ~map[V]struct{}](s S, consumer util.ErrConsumer[V]) error {
+	return dict.EachKeyErr(s, func(v V, _ struct{}) error { return consumer(v) })
+}
@@ -0,0 +1,76 @@
+package set_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestEachErrInt64(t *testing.T) {
+
+	errStop := errors.New("stop")
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       set.Set[int64]
+		limit       int
+		wantErr     error
+		wantVisited int
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			limit:       2,
+			wantErr:     nil,
+			wantVisited: 0,
+		},
+		"empty": {
+			items:       EmptyInt64Set,
+			limit:       2,
+			wantErr:     nil,
+			wantVisited: 0,
+		},
+		"stop": {
+			items:       DefaultInt64Set,
+			limit:       2,
+			wantErr:     errStop,
+			wantVisited: 2,
+		},
+		"all": {
+			items:       DefaultInt64Set,
+			limit:       10,
+			wantErr:     nil,
+			wantVisited: 5,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotVisited := 0
+		gotErr := set.EachErr(testCase.items, func(item int64) error {
+			gotVisited++
+			if gotVisited >= testCase.limit {
+				return errStop
+			}
+			return nil
+		})
+
+		// assert
+		require.Equal(t, testCase.wantErr, gotErr, "wrong error!")
+		require.Equal(t, testCase.wantVisited, gotVisited, "wrong visited!")
+	})
+}
@@ -0,0 +1,10 @@
+package set
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func EachWhile[V comparable, S ~map[V]struct{}](s S, consumer util.Predicate[V]) bool {
+	return dict.EachKeyWhile(s, util.TestOnFirstArg[V, struct{}](consumer))
+}
@@ -0,0 +1,23 @@
+package set
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func GroupBy[V comparable, K comparable, S ~map[V]struct{}](s S, key util.Transformer[V, K]) dict.DeepDict[K, S] {
+	if s == nil {
+		return nil
+	}
+	groups := dict.DeepDict[K, S]{}
+	for v := range s {
+		k := key(v)
+		group, found := groups[k]
+		if !found {
+			group = S{}
+			groups[k] = group
+		}
+		group[v] = struct{}{}
+	}
+	return groups
+}
@@ -0,0 +1,55 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestGroupByInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      set.Set[int64]
+		wantGroups dict.DeepDict[int64, set.Set[int64]]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			wantGroups: nil,
+		},
+		"empty": {
+			items:      EmptyInt64Set,
+			wantGroups: dict.DeepDict[int64, set.Set[int64]]{},
+		},
+		"modulo-3": {
+			items: DefaultInt64Set,
+			wantGroups: dict.DeepDict[int64, set.Set[int64]]{
+				0: set.New[int64](21, 12, 87),
+				1: set.New[int64](34, 52),
+			},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotGroups := set.GroupBy(testCase.items, func(i int64) int64 { return i % 3 })
+
+		// assert
+		require.Equal(t, testCase.wantGroups, gotGroups, "wrong groups!")
+	})
+}
@@ -0,0 +1,136 @@
+package set_test
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/set"
+)
+
+// Tag implements util.Equaler and util.Hasher, ignoring the case of its words
+type Tag struct {
+	Words []string
+}
+
+func (t Tag) Equal(other Tag) bool {
+	if len(t.Words) != len(other.Words) {
+		return false
+	}
+	for i, word := range t.Words {
+		if !strings.EqualFold(word, other.Words[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Tag) Hash() uint64 {
+	h := fnv.New64a()
+	for _, word := range t.Words {
+		h.Write([]byte(strings.ToLower(word)))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+// Label implements util.Equaler only, ignoring the case of its words
+type Label struct {
+	Words []string
+}
+
+func (l Label) Equal(other Label) bool {
+	return Tag(l).Equal(Tag(other))
+}
+
+// PlainTag is the same structure, hashed and compared through reflection
+type PlainTag struct {
+	Words []string
+}
+
+func TestHasher(t *testing.T) {
+
+	tags := set.NewDeep(
+		Tag{Words: []string{"hello", "world"}},
+		Tag{Words: []string{"HELLO", "World"}}, // duplicate
+		Tag{Words: []string{"foo"}},
+	)
+	require.Equal(t, 2, tags.Len(), "len after build")
+
+	// find
+
+	require.True(t, tags.Find(Tag{Words: []string{"Hello", "WORLD"}}), "find uses hasher and equaler")
+	require.False(t, tags.Find(Tag{Words: []string{"hello"}}), "find other words")
+
+	// remove
+
+	require.True(t, tags.Remove(Tag{Words: []string{"FOO"}}), "remove uses hasher and equaler")
+	require.Equal(t, 1, tags.Len(), "len after remove")
+
+	// fallback on reflection
+
+	plains := set.NewDeep(PlainTag{Words: []string{"hello"}}, PlainTag{Words: []string{"Hello"}})
+	require.Equal(t, 2, plains.Len(), "reflection is case sensitive")
+	require.True(t, plains.Find(PlainTag{Words: []string{"hello"}}), "find through reflection")
+}
+
+func TestEqualerWithoutHasher(t *testing.T) {
+
+	// equal values must share a bucket, even if the type has no Hash method
+
+	labels := set.NewDeep(
+		Label{Words: []string{"hello", "world"}},
+		Label{Words: []string{"HELLO", "World"}}, // duplicate
+		Label{Words: []string{"foo"}},
+	)
+	require.Equal(t, 2, labels.Len(), "len after build")
+	require.False(t, labels.Add(Label{Words: []string{"Foo"}}), "add duplicate")
+
+	// find
+
+	require.True(t, labels.Find(Label{Words: []string{"Hello", "WORLD"}}), "find uses equaler")
+	require.False(t, labels.Find(Label{Words: []string{"hello"}}), "find other words")
+
+	// remove
+
+	require.True(t, labels.Remove(Label{Words: []string{"FOO"}}), "remove uses equaler")
+	require.Equal(t, 1, labels.Len(), "len after remove")
+
+	// standard types with an Equal method
+
+	now := time.Now()
+	times := set.NewDeep(now, now.Round(0), now.UTC())
+	require.Equal(t, 1, times.Len(), "time instants")
+	require.True(t, times.Find(now.In(time.FixedZone("test", 3600))), "find time instant")
+}
+
+//
+// benchmarks
+//
+
+func BenchmarkHasher(b *testing.B) {
+	const size = 1000
+	tags := set.NewDeep[Tag]()
+	plains := set.NewDeep[PlainTag]()
+	for i := 0; i < size; i++ {
+		tags.Add(Tag{Words: []string{"tag", fmt.Sprint(i)}})
+		plains.Add(PlainTag{Words: []string{"tag", fmt.Sprint(i)}})
+	}
+	tag := Tag{Words: []string{"tag", fmt.Sprint(size / 2)}}
+	plain := PlainTag{Words: []string{"tag", fmt.Sprint(size / 2)}}
+
+	b.Run("find/hasher", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tags.Find(tag)
+		}
+	})
+	b.Run("find/reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			plains.Find(plain)
+		}
+	})
+}
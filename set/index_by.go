@@ -0,0 +1,24 @@
+package set
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func IndexBy[V comparable, K comparable, S ~map[V]struct{}](s S, key util.Transformer[V, K], resolver dict.Resolver[K, V]) dict.DeepDict[K, V] {
+	if s == nil {
+		return nil
+	}
+	// note:
+	//  - the set iteration order is random, so the resolver should not depend on the order of old and new
+	//  - a nil resolver keeps the last value visited
+	index := make(dict.DeepDict[K, V], len(s))
+	for v := range s {
+		k := key(v)
+		if old, found := index[k]; found && resolver != nil {
+			v = resolver(k, old, v)
+		}
+		index[k] = v
+	}
+	return index
+}
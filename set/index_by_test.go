@@ -0,0 +1,61 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestIndexByStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items     set.Set[Item]
+		resolver  dict.Resolver[int64, Item]
+		wantIndex dict.DeepDict[int64, Item]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:     nil,
+			resolver:  nil,
+			wantIndex: nil,
+		},
+		"empty": {
+			items:     EmptyItemSet,
+			resolver:  nil,
+			wantIndex: dict.DeepDict[int64, Item]{},
+		},
+		"keep-max": {
+			items: DefaultItemSet,
+			resolver: func(_ int64, old Item, new Item) Item {
+				if old.Value > new.Value {
+					return old
+				}
+				return new
+			},
+			wantIndex: dict.DeepDict[int64, Item]{0: {Value: 87}, 1: {Value: 52}},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotIndex := set.IndexBy(testCase.items, func(i Item) int64 { return i.Value % 3 }, testCase.resolver)
+
+		// assert
+		require.Equal(t, testCase.wantIndex, gotIndex, "wrong index!")
+	})
+}
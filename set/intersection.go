@@ -0,0 +1,28 @@
+package set
+
+func Intersection[V comparable, S ~map[V]struct{}](left S, right S) S {
+	if left == nil && right == nil {
+		return nil
+	}
+	// iterate over the smaller operand
+	if len(left) > len(right) {
+		left, right = right, left
+	}
+	intersection := make(S, len(left))
+	for v := range left {
+		if _, found := right[v]; found {
+			intersection[v] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+func IntersectionWith[V comparable, S ~map[V]struct{}](s *S, other S) bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+	return RemoveIf(s, func(v V) bool {
+		_, found := other[v]
+		return !found
+	})
+}
@@ -0,0 +1,68 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// json
+//
+// note: a set is encoded as a JSON array, in random order unless wrapped with SortedJSON
+
+func (s Set[V]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.Values())
+}
+
+func (s *Set[V]) UnmarshalJSON(data []byte) error {
+	var values []V
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	if values == nil {
+		*s = nil
+		return nil
+	}
+	*s = New(values...)
+	return nil
+}
+
+// SortedJSON wraps the set to be encoded as a JSON array sorted by the comparator,
+// or by encoded value when the comparator is nil
+func SortedJSON[V comparable, S ~map[V]struct{}](s S, comparator util.Comparator[V]) json.Marshaler {
+	return sortedJSON[V, S]{set: s, comparator: comparator}
+}
+
+type sortedJSON[V comparable, S ~map[V]struct{}] struct {
+	set        S
+	comparator util.Comparator[V]
+}
+
+func (s sortedJSON[V, S]) MarshalJSON() ([]byte, error) {
+	if s.set == nil {
+		return []byte("null"), nil
+	}
+	values := make([]V, 0, len(s.set))
+	for v := range s.set {
+		values = append(values, v)
+	}
+	if s.comparator != nil {
+		sortDeterministic(values, s.comparator)
+		return json.Marshal(values)
+	}
+	encoded := make([]json.RawMessage, len(values))
+	for i, v := range values {
+		e, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = e
+	}
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+	return json.Marshal(encoded)
+}
@@ -0,0 +1,74 @@
+package set_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestJSON(t *testing.T) {
+
+	// encoded as an array
+
+	data, err := json.Marshal(DefaultInt64Set)
+	require.NoError(t, err, "marshal")
+	var values []int64
+	require.NoError(t, json.Unmarshal(data, &values), "unmarshal as array")
+	require.ElementsMatch(t, []int64{21, 12, 34, 87, 52}, values, "wrong json!")
+
+	// round trip
+
+	var decoded set.Set[int64]
+	require.NoError(t, json.Unmarshal(data, &decoded), "unmarshal")
+	assertEqual(t, DefaultInt64Set, decoded, "wrong round trip!")
+
+	// duplicates are merged
+
+	require.NoError(t, json.Unmarshal([]byte(`[1,2,1]`), &decoded), "unmarshal duplicates")
+	assertEqual(t, set.New[int64](1, 2), decoded, "wrong duplicates!")
+
+	// nil
+
+	data, err = json.Marshal(set.Set[int64](nil))
+	require.NoError(t, err, "marshal nil")
+	require.Equal(t, "null", string(data), "wrong nil json!")
+	require.NoError(t, json.Unmarshal(data, &decoded), "unmarshal nil")
+	require.Nil(t, decoded, "wrong nil round trip!")
+
+	// struct values
+
+	data, err = json.Marshal(struct {
+		Items set.Set[Item] `json:"items"`
+	}{Items: set.New(Item{Value: 1})})
+	require.NoError(t, err, "marshal struct")
+	require.Equal(t, `{"items":[{"Value":1}]}`, string(data), "wrong struct json!")
+}
+
+func TestSortedJSON(t *testing.T) {
+
+	// sorted by comparator
+
+	data, err := json.Marshal(set.SortedJSON(DefaultInt64Set, util.Compare[int64]))
+	require.NoError(t, err, "marshal")
+	require.Equal(t, `[12,21,34,52,87]`, string(data), "wrong sorted json!")
+
+	data, err = json.Marshal(set.SortedJSON(DefaultInt64Set, util.Reverse(util.Compare[int64])))
+	require.NoError(t, err, "marshal reversed")
+	require.Equal(t, `[87,52,34,21,12]`, string(data), "wrong reversed json!")
+
+	// sorted by encoded value
+
+	data, err = json.Marshal(set.SortedJSON(set.New(Item{Value: 2}, Item{Value: 1}), nil))
+	require.NoError(t, err, "marshal items")
+	require.Equal(t, `[{"Value":1},{"Value":2}]`, string(data), "wrong encoded order!")
+
+	// nil
+
+	data, err = json.Marshal(set.SortedJSON[int64, set.Set[int64]](nil, nil))
+	require.NoError(t, err, "marshal nil")
+	require.Equal(t, "null", string(data), "wrong nil json!")
+}
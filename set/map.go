@@ -0,0 +1,14 @@
+package set
+
+import "github.com/gvaligiani/al.go/util"
+
+func Map[V comparable, O comparable, S ~map[V]struct{}](s S, transformer util.Transformer[V, O]) Set[O] {
+	if s == nil {
+		return nil
+	}
+	mapped := make(Set[O], len(s))
+	for v := range s {
+		mapped[transformer(v)] = struct{}{}
+	}
+	return mapped
+}
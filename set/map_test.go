@@ -0,0 +1,104 @@
+package set_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestMapInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       set.Set[int64]
+		transformer util.Transformer[int64, int64]
+		wantItems   set.Set[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(i int64) int64 { return i % 10 },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyInt64Set,
+			transformer: func(i int64) int64 { return i % 10 },
+			wantItems:   EmptyInt64Set,
+		},
+		"double": {
+			items:       DefaultInt64Set,
+			transformer: func(i int64) int64 { return i * 2 },
+			wantItems:   set.New[int64](42, 24, 68, 174, 104),
+		},
+		"merge-duplicates": {
+			items:       DefaultInt64Set,
+			transformer: func(i int64) int64 { return i % 10 },
+			wantItems:   set.New[int64](1, 2, 4, 7),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := set.Map(testCase.items, testCase.transformer)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
+
+func TestMapStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items       set.Set[Item]
+		transformer util.Transformer[Item, int64]
+		wantItems   set.Set[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:       nil,
+			transformer: func(item Item) int64 { return item.Value },
+			wantItems:   nil,
+		},
+		"empty": {
+			items:       EmptyItemSet,
+			transformer: func(item Item) int64 { return item.Value },
+			wantItems:   EmptyInt64Set,
+		},
+		"value": {
+			items:       DefaultItemSet,
+			transformer: func(item Item) int64 { return item.Value },
+			wantItems:   DefaultInt64Set,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := set.Map(testCase.items, testCase.transformer)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+	})
+}
@@ -0,0 +1,333 @@
+package set
+
+import (
+	"sort"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+// MultiSet is a set counting the occurrences of each value
+//
+// note:
+//   - only values with a positive count are stored
+//   - predicates, each, find and copy work on the distinct values
+type MultiSet[V comparable] map[V]int
+
+// Occurrence is a value with its count in a MultiSet
+type Occurrence[V comparable] struct {
+	Value V
+	Count int
+}
+
+// builder
+
+func NewMulti[V comparable](values ...V) MultiSet[V] {
+	return NewMultiFrom(values)
+}
+
+func NewMultiFrom[V comparable, L ~[]V](l L) MultiSet[V] {
+	s := make(MultiSet[V], len(l))
+	for _, v := range l {
+		s[v]++
+	}
+	return s
+}
+
+func (s *MultiSet[V]) With(value V, count int) *MultiSet[V] {
+	s.Add(value, count)
+	return s
+}
+
+// getter
+
+func (s MultiSet[V]) Len() int {
+	return len(s)
+}
+
+func (s MultiSet[V]) Total() int {
+	total := 0
+	for _, count := range s {
+		total += count
+	}
+	return total
+}
+
+func (s MultiSet[V]) Count(value V) int {
+	return s[value]
+}
+
+func (s MultiSet[V]) Values() []V {
+	l := make([]V, 0, len(s))
+	for v := range s {
+		l = append(l, v)
+	}
+	return l
+}
+
+func (s MultiSet[V]) ToSet() Set[V] {
+	if s == nil {
+		return nil
+	}
+	set := make(Set[V], len(s))
+	for v := range s {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// MostCommon returns the n values with the highest counts, from the most to the least common
+//
+// note:
+//   - n < 0 returns all the values
+//   - the order of values with the same count is unspecified
+func (s MultiSet[V]) MostCommon(n int) []Occurrence[V] {
+	occurrences := make([]Occurrence[V], 0, len(s))
+	for v, count := range s {
+		occurrences = append(occurrences, Occurrence[V]{Value: v, Count: count})
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Count > occurrences[j].Count })
+	if n >= 0 && n < len(occurrences) {
+		occurrences = occurrences[:n]
+	}
+	return occurrences
+}
+
+// state
+
+func (s MultiSet[V]) IsEmpty() bool {
+	return len(s) == 0
+}
+
+func (s MultiSet[V]) AllOf(predicate util.Predicate[V]) bool {
+	return dict.AllKeyOf(s, util.TestOnFirstArg[V, int](predicate))
+}
+
+func (s MultiSet[V]) AllCountOf(predicate util.BiPredicate[V, int]) bool {
+	return dict.AllKeyOf(s, predicate)
+}
+
+func (s MultiSet[V]) AnyOf(predicate util.Predicate[V]) bool {
+	return dict.AnyKeyOf(s, util.TestOnFirstArg[V, int](predicate))
+}
+
+func (s MultiSet[V]) AnyCountOf(predicate util.BiPredicate[V, int]) bool {
+	return dict.AnyKeyOf(s, predicate)
+}
+
+func (s MultiSet[V]) NoneOf(predicate util.Predicate[V]) bool {
+	return dict.NoKeyOf(s, util.TestOnFirstArg[V, int](predicate))
+}
+
+func (s MultiSet[V]) NoCountOf(predicate util.BiPredicate[V, int]) bool {
+	return dict.NoKeyOf(s, predicate)
+}
+
+// each
+
+func (s MultiSet[V]) Each(consumer util.Consumer[V]) {
+	dict.EachKey(s, func(v V, _ int) { consumer(v) })
+}
+
+func (s MultiSet[V]) EachCount(consumer util.BiConsumer[V, int]) {
+	dict.EachKey(s, consumer)
+}
+
+func (s MultiSet[V]) EachWhile(consumer util.Predicate[V]) bool {
+	return dict.EachKeyWhile(s, util.TestOnFirstArg[V, int](consumer))
+}
+
+// find
+
+func (s MultiSet[V]) Find(value V) bool {
+	_, found := s[value]
+	return found
+}
+
+func (s MultiSet[V]) FindIf(predicate util.Predicate[V]) (V, bool) {
+	v, _, found := dict.FindIfKey(s, util.TestOnFirstArg[V, int](predicate))
+	return v, found
+}
+
+func (s MultiSet[V]) FindIfCount(predicate util.BiPredicate[V, int]) (V, int, bool) {
+	return dict.FindIfKey(s, predicate)
+}
+
+func (s MultiSet[V]) FindIfNot(predicate util.Predicate[V]) (V, bool) {
+	return s.FindIf(util.Not(predicate))
+}
+
+// copy
+
+func (s MultiSet[V]) Copy() MultiSet[V] {
+	return dict.Copy(s)
+}
+
+func (s MultiSet[V]) CopyIf(predicate util.Predicate[V]) MultiSet[V] {
+	return dict.CopyIfKey(s, util.TestOnFirstArg[V, int](predicate))
+}
+
+func (s MultiSet[V]) CopyIfCount(predicate util.BiPredicate[V, int]) MultiSet[V] {
+	return dict.CopyIfKey(s, predicate)
+}
+
+func (s MultiSet[V]) CopyIfNot(predicate util.Predicate[V]) MultiSet[V] {
+	return s.CopyIf(util.Not(predicate))
+}
+
+// compare
+
+func (s MultiSet[V]) Equal(other MultiSet[V]) bool {
+	return dict.Equal(s, other)
+}
+
+func (s MultiSet[V]) IsSubsetOf(other MultiSet[V]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v, count := range s {
+		if other[v] < count {
+			return false
+		}
+	}
+	return true
+}
+
+// algebra
+
+// Union keeps the maximum count of each value
+func (s MultiSet[V]) Union(other MultiSet[V]) MultiSet[V] {
+	if s == nil && other == nil {
+		return nil
+	}
+	union := make(MultiSet[V], len(s)+len(other))
+	for v, count := range s {
+		union[v] = count
+	}
+	for v, count := range other {
+		if count > union[v] {
+			union[v] = count
+		}
+	}
+	return union
+}
+
+// Intersection keeps the minimum count of each value
+func (s MultiSet[V]) Intersection(other MultiSet[V]) MultiSet[V] {
+	if s == nil && other == nil {
+		return nil
+	}
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	intersection := make(MultiSet[V], len(small))
+	for v, count := range small {
+		if otherCount, found := large[v]; found {
+			if otherCount < count {
+				count = otherCount
+			}
+			intersection[v] = count
+		}
+	}
+	return intersection
+}
+
+// Sum adds the counts of each value
+func (s MultiSet[V]) Sum(other MultiSet[V]) MultiSet[V] {
+	if s == nil && other == nil {
+		return nil
+	}
+	sum := make(MultiSet[V], len(s)+len(other))
+	for v, count := range s {
+		sum[v] = count
+	}
+	for v, count := range other {
+		sum[v] += count
+	}
+	return sum
+}
+
+// Difference subtracts the counts of each value, dropping the values whose count is no longer positive
+func (s MultiSet[V]) Difference(other MultiSet[V]) MultiSet[V] {
+	if s == nil {
+		return nil
+	}
+	difference := make(MultiSet[V], len(s))
+	for v, count := range s {
+		if count > other[v] {
+			difference[v] = count - other[v]
+		}
+	}
+	return difference
+}
+
+// modifier
+
+func (s *MultiSet[V]) Add(value V, count int) bool {
+	if s == nil || count <= 0 {
+		return false
+	}
+	if *s == nil {
+		*s = MultiSet[V]{}
+	}
+	(*s)[value] += count
+	return true
+}
+
+// Remove removes up to count occurrences of the value
+func (s *MultiSet[V]) Remove(value V, count int) bool {
+	if s == nil || count <= 0 {
+		return false
+	}
+	current, found := (*s)[value]
+	if !found {
+		return false
+	}
+	if current > count {
+		(*s)[value] = current - count
+	} else {
+		delete(*s, value)
+	}
+	return true
+}
+
+func (s *MultiSet[V]) RemoveAll(value V) bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+	if _, found := (*s)[value]; found {
+		delete(*s, value)
+		return true
+	}
+	return false
+}
+
+func (s *MultiSet[V]) Clear() bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+	*s = MultiSet[V]{}
+	return true
+}
+
+func (s *MultiSet[V]) RemoveIf(predicate util.Predicate[V]) bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+	return dict.RemoveIfKey(s, util.TestOnFirstArg[V, int](predicate))
+}
+
+func (s *MultiSet[V]) RemoveIfCount(predicate util.BiPredicate[V, int]) bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+	return dict.RemoveIfKey(s, predicate)
+}
+
+func (s *MultiSet[V]) KeepIf(predicate util.Predicate[V]) bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+	return dict.KeepIfKey(s, util.TestOnFirstArg[V, int](predicate))
+}
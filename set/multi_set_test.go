@@ -0,0 +1,96 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/list"
+	"github.com/gvaligiani/al.go/set"
+)
+
+func TestMultiSet(t *testing.T) {
+
+	// builder
+
+	s := set.NewMultiFrom(list.New("a", "b", "a", "c", "a", "b"))
+	s.With("d", 2)
+	require.Equal(t, set.MultiSet[string]{"a": 3, "b": 2, "c": 1, "d": 2}, s, "wrong built multiset")
+
+	// getter
+
+	require.Equal(t, 4, s.Len(), "len")
+	require.Equal(t, 8, s.Total(), "total")
+	require.Equal(t, 3, s.Count("a"), "count a")
+	require.Equal(t, 0, s.Count("z"), "count z")
+	require.Equal(t, set.New("a", "b", "c", "d"), s.ToSet(), "to set")
+
+	// most common
+
+	require.Equal(t, []set.Occurrence[string]{{Value: "a", Count: 3}}, s.MostCommon(1), "most common")
+	require.Len(t, s.MostCommon(-1), 4, "all most common")
+	require.Equal(t, 1, s.MostCommon(10)[3].Count, "least common")
+
+	// add / remove
+
+	require.False(t, s.Add("a", 0), "add 0")
+	require.True(t, s.Add("a", 2), "add 2")
+	require.Equal(t, 5, s.Count("a"), "count a after add")
+	require.True(t, s.Remove("a", 4), "remove 4")
+	require.Equal(t, 1, s.Count("a"), "count a after remove")
+	require.True(t, s.Remove("c", 5), "remove more than count")
+	require.False(t, s.Find("c"), "c removed")
+	require.False(t, s.Remove("c", 1), "remove missing")
+	require.True(t, s.RemoveAll("d"), "remove all d")
+
+	// predicate
+
+	require.True(t, s.AllOf(func(v string) bool { return v < "c" }), "all_of")
+	require.True(t, s.AnyCountOf(func(_ string, count int) bool { return count == 2 }), "any_count_of")
+	require.True(t, s.NoneOf(func(v string) bool { return v == "z" }), "none_of")
+	value, count, found := s.FindIfCount(func(_ string, count int) bool { return count > 1 })
+	require.Equal(t, "b", value, "find if count value")
+	require.Equal(t, 2, count, "find if count")
+	require.True(t, found, "found")
+	require.Equal(t, set.MultiSet[string]{"b": 2}, s.CopyIf(func(v string) bool { return v == "b" }), "copy if")
+
+	// clear
+
+	require.True(t, s.Clear(), "clear")
+	require.True(t, s.IsEmpty(), "is_empty after clear")
+	require.False(t, s.Clear(), "clear twice")
+
+	// zero value
+
+	var zero set.MultiSet[int]
+	require.True(t, zero.Add(1, 2), "add to zero value")
+	require.Equal(t, 2, zero.Total(), "zero value total")
+}
+
+func TestMultiSetAlgebra(t *testing.T) {
+
+	left := set.MultiSet[string]{"a": 3, "b": 1}
+	right := set.MultiSet[string]{"a": 1, "b": 2, "c": 1}
+
+	require.Equal(t, set.MultiSet[string]{"a": 3, "b": 2, "c": 1}, left.Union(right), "union")
+	require.Equal(t, set.MultiSet[string]{"a": 1, "b": 1}, left.Intersection(right), "intersection")
+	require.Equal(t, set.MultiSet[string]{"a": 4, "b": 3, "c": 1}, left.Sum(right), "sum")
+	require.Equal(t, set.MultiSet[string]{"a": 2}, left.Difference(right), "difference")
+
+	require.True(t, left.Intersection(right).IsSubsetOf(left), "intersection subset of left")
+	require.False(t, left.IsSubsetOf(right), "left subset of right")
+	require.True(t, left.Equal(left.Copy()), "equal copy")
+
+	// nil
+
+	var empty set.MultiSet[string]
+	require.Nil(t, empty.Union(nil), "nil union")
+	require.Equal(t, left, left.Union(nil), "union with nil")
+	require.Equal(t, set.MultiSet[string]{}, left.Intersection(nil), "intersection with nil")
+	require.Equal(t, left, left.Difference(nil), "difference with nil")
+
+	// sources unchanged
+
+	require.Equal(t, set.MultiSet[string]{"a": 3, "b": 1}, left, "left modified")
+	require.Equal(t, set.MultiSet[string]{"a": 1, "b": 2, "c": 1}, right, "right modified")
+}
@@ -0,0 +1,10 @@
+package set
+
+import (
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func Partition[V comparable, S ~map[V]struct{}](s S, predicate util.Predicate[V]) (S, S) {
+	return dict.PartitionKey(s, util.TestOnFirstArg[V, struct{}](predicate))
+}
@@ -0,0 +1,60 @@
+package set_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestPartitionInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items        set.Set[int64]
+		predicate    util.Predicate[int64]
+		wantMatching set.Set[int64]
+		wantOthers   set.Set[int64]
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:        nil,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: nil,
+			wantOthers:   nil,
+		},
+		"empty": {
+			items:        EmptyInt64Set,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: set.Set[int64]{},
+			wantOthers:   set.Set[int64]{},
+		},
+		"even": {
+			items:        DefaultInt64Set,
+			predicate:    func(i int64) bool { return i%2 == 0 },
+			wantMatching: set.New[int64](12, 34, 52),
+			wantOthers:   set.New[int64](21, 87),
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotMatching, gotOthers := testCase.items.Partition(testCase.predicate)
+
+		// assert
+		assertEqual(t, testCase.wantMatching, gotMatching, "wrong matching items!")
+		assertEqual(t, testCase.wantOthers, gotOthers, "wrong other items!")
+	})
+}
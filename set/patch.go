@@ -0,0 +1,79 @@
+package set
+
+import (
+	"fmt"
+
+	"github.com/gvaligiani/al.go/dict"
+)
+
+// Patch turns a set equal to its base into its target, and back
+//
+// note:
+//   - Apply and Revert check the whole patch against the set before modifying it,
+//     and fail with dict.ErrConflict if the set does not match, leaving it unchanged
+//   - only the patched values are checked, the other values are left as is
+type Patch[V comparable, S ~map[V]struct{}] struct {
+	delta Delta[V]
+}
+
+// builder
+
+func NewPatch[V comparable, S ~map[V]struct{}](base S, target S) *Patch[V, S] {
+	return NewPatchFromDelta[V, S](Diff(base, target))
+}
+
+// NewPatchFromDelta rebuilds a patch from its delta, for instance received from another service
+func NewPatchFromDelta[V comparable, S ~map[V]struct{}](delta Delta[V]) *Patch[V, S] {
+	return &Patch[V, S]{delta: delta}
+}
+
+// getter
+
+func (p *Patch[V, S]) Delta() Delta[V] {
+	return p.delta
+}
+
+func (p *Patch[V, S]) IsEmpty() bool {
+	return p.delta.IsEmpty()
+}
+
+func (p *Patch[V, S]) String() string {
+	return p.delta.String()
+}
+
+// Inverse returns the patch from the target back to the base
+func (p *Patch[V, S]) Inverse() *Patch[V, S] {
+	return &Patch[V, S]{delta: Delta[V]{OnlyLeft: p.delta.OnlyRight, OnlyRight: p.delta.OnlyLeft}}
+}
+
+// modifier
+
+func (p *Patch[V, S]) Apply(target *S) error {
+	if target == nil {
+		return fmt.Errorf("%w: nil target", dict.ErrConflict)
+	}
+	for v := range p.delta.OnlyLeft {
+		if _, found := (*target)[v]; !found {
+			return fmt.Errorf("%w: %v is missing", dict.ErrConflict, v)
+		}
+	}
+	for v := range p.delta.OnlyRight {
+		if _, found := (*target)[v]; found {
+			return fmt.Errorf("%w: %v already exists", dict.ErrConflict, v)
+		}
+	}
+	if *target == nil && len(p.delta.OnlyRight) > 0 {
+		*target = make(S, len(p.delta.OnlyRight))
+	}
+	for v := range p.delta.OnlyLeft {
+		delete(*target, v)
+	}
+	for v := range p.delta.OnlyRight {
+		(*target)[v] = struct{}{}
+	}
+	return nil
+}
+
+func (p *Patch[V, S]) Revert(target *S) error {
+	return p.Inverse().Apply(target)
+}
@@ -0,0 +1,63 @@
+package set_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gvaligiani/al.go/dict"
+	"github.com/gvaligiani/al.go/set"
+)
+
+func TestPatch(t *testing.T) {
+
+	patch := set.NewPatch(DefaultInt64Set, OtherInt64Set)
+	require.Equal(t, "- 34\n+ 69\n", patch.String(), "wrong text!")
+
+	// apply
+
+	target := DefaultInt64Set.Copy()
+	require.NoError(t, patch.Apply(&target), "apply")
+	assertEqual(t, OtherInt64Set, target, "wrong applied set!")
+
+	// apply twice conflicts and leaves the set unchanged
+
+	require.ErrorIs(t, patch.Apply(&target), dict.ErrConflict, "apply twice")
+	assertEqual(t, OtherInt64Set, target, "set modified by a conflicting apply!")
+
+	// revert
+
+	require.NoError(t, patch.Revert(&target), "revert")
+	assertEqual(t, DefaultInt64Set, target, "wrong reverted set!")
+
+	// empty patch
+
+	require.True(t, set.NewPatch(DefaultInt64Set, DefaultInt64Set).IsEmpty(), "is_empty")
+
+	// nil
+
+	var empty set.Set[int64]
+	require.NoError(t, set.NewPatch(nil, DefaultInt64Set).Apply(&empty), "apply to nil")
+	assertEqual(t, DefaultInt64Set, empty, "wrong set applied to nil!")
+}
+
+func TestPatchFromDelta(t *testing.T) {
+
+	// the delta is shipped as json and the patch rebuilt on the other side
+
+	data, err := json.Marshal(set.Diff(DefaultInt64Set, OtherInt64Set))
+	require.NoError(t, err, "marshal delta")
+
+	var delta set.Delta[int64]
+	require.NoError(t, json.Unmarshal(data, &delta), "unmarshal delta")
+	patch := set.NewPatchFromDelta[int64, set.Set[int64]](delta)
+
+	// apply and revert
+
+	target := DefaultInt64Set.Copy()
+	require.NoError(t, patch.Apply(&target), "apply")
+	assertEqual(t, OtherInt64Set, target, "wrong applied set!")
+	require.NoError(t, patch.Revert(&target), "revert")
+	assertEqual(t, DefaultInt64Set, target, "wrong reverted set!")
+}
@@ -0,0 +1,12 @@
+package set
+
+import "github.com/gvaligiani/al.go/util"
+
+func Reduce[V comparable, A any, S ~map[V]struct{}](s S, initial A, reducer util.BiTransformer[A, V, A]) A {
+	// note: the map iteration order is random, so the reducer should be commutative
+	acc := initial
+	for v := range s {
+		acc = reducer(acc, v)
+	}
+	return acc
+}
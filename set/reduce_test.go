@@ -0,0 +1,100 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestReduceInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items   set.Set[int64]
+		initial int64
+		wantSum int64
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:   nil,
+			initial: 5,
+			wantSum: 5,
+		},
+		"empty": {
+			items:   EmptyInt64Set,
+			initial: 5,
+			wantSum: 5,
+		},
+		"all": {
+			items:   DefaultInt64Set,
+			initial: 5,
+			wantSum: 5 + 21 + 12 + 34 + 87 + 52,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotSum := set.Reduce(testCase.items, testCase.initial, func(acc int64, item int64) int64 { return acc + item })
+
+		// assert
+		require.Equalf(t, testCase.wantSum, gotSum, "wrong sum!")
+	})
+}
+
+func TestReduceStruct(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items   set.Set[Item]
+		wantMax int64
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:   nil,
+			wantMax: -1,
+		},
+		"empty": {
+			items:   EmptyItemSet,
+			wantMax: -1,
+		},
+		"all": {
+			items:   DefaultItemSet,
+			wantMax: 87,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotMax := set.Reduce(testCase.items, int64(-1), func(acc int64, item Item) int64 {
+			if item.Value > acc {
+				return item.Value
+			}
+			return acc
+		})
+
+		// assert
+		require.Equalf(t, testCase.wantMax, gotMax, "wrong max!")
+	})
+}
@@ -55,6 +55,28 @@ func (s Set[V]) Each(consumer util.Consumer[V]) {
 	Each(s, consumer)
 }
 
+func (s Set[V]) EachWhile(consumer util.Predicate[V]) bool {
+	return EachWhile(s, consumer)
+}
+
+func (s Set[V]) EachErr(consumer util.ErrConsumer[V]) error {
+	return EachErr(s, consumer)
+}
+
+// sorted
+
+func (s Set[V]) SortedValues(comparator util.Comparator[V]) []V {
+	return SortedValues(s, comparator)
+}
+
+func (s Set[V]) SortedEach(comparator util.Comparator[V], consumer util.Consumer[V]) {
+	SortedEach(s, comparator, consumer)
+}
+
+func (s Set[V]) EachOrdered(order []V, consumer util.Consumer[V]) {
+	EachOrdered(s, order, consumer)
+}
+
 // find
 
 func (s Set[V]) Find(value V) bool {
@@ -83,6 +105,48 @@ func (s Set[V]) CopyIfNot(predicate util.Predicate[V]) Set[V] {
 	return Set[V](CopyIfNot(s, predicate))
 }
 
+func (s Set[V]) Partition(predicate util.Predicate[V]) (Set[V], Set[V]) {
+	return Partition(s, predicate)
+}
+
+// algebra
+
+func (s Set[V]) Union(other Set[V]) Set[V] {
+	return Union(s, other)
+}
+
+func (s Set[V]) Intersection(other Set[V]) Set[V] {
+	return Intersection(s, other)
+}
+
+func (s Set[V]) Difference(other Set[V]) Set[V] {
+	return Difference(s, other)
+}
+
+func (s Set[V]) SymmetricDifference(other Set[V]) Set[V] {
+	return SymmetricDifference(s, other)
+}
+
+func (s Set[V]) IsSubsetOf(other Set[V]) bool {
+	return IsSubsetOf(s, other)
+}
+
+func (s Set[V]) IsProperSubsetOf(other Set[V]) bool {
+	return IsProperSubsetOf(s, other)
+}
+
+func (s Set[V]) IsSupersetOf(other Set[V]) bool {
+	return IsSupersetOf(s, other)
+}
+
+func (s Set[V]) IsProperSupersetOf(other Set[V]) bool {
+	return IsProperSupersetOf(s, other)
+}
+
+func (s Set[V]) IsDisjoint(other Set[V]) bool {
+	return IsDisjoint(s, other)
+}
+
 // modifier
 
 func (s *Set[V]) Add(value V) bool {
@@ -128,3 +192,19 @@ func (s *Set[V]) KeepIf(predicate util.Predicate[V]) bool {
 	}
 	return KeepIf(s, predicate)
 }
+
+func (s *Set[V]) UnionWith(other Set[V]) bool {
+	return UnionWith(s, other)
+}
+
+func (s *Set[V]) IntersectionWith(other Set[V]) bool {
+	return IntersectionWith(s, other)
+}
+
+func (s *Set[V]) DifferenceWith(other Set[V]) bool {
+	return DifferenceWith(s, other)
+}
+
+func (s *Set[V]) SymmetricDifferenceWith(other Set[V]) bool {
+	return SymmetricDifferenceWith(s, other)
+}
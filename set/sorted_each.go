@@ -0,0 +1,56 @@
+package set
+
+import (
+	"sort"
+
+	"github.com/gvaligiani/al.go/util"
+)
+
+// note:
+//   - the comparator is required and a nil one panics, use the *Ordered variants for the natural order
+//   - ties of the comparator are broken by the deep hash of the values, so that the order is the same on every run
+//     whatever the iteration order of the map
+
+func SortedValues[V comparable, S ~map[V]struct{}](s S, comparator util.Comparator[V]) []V {
+	values := make([]V, 0, len(s))
+	for v := range s {
+		values = append(values, v)
+	}
+	sortDeterministic(values, comparator)
+	return values
+}
+
+func SortedEach[V comparable, S ~map[V]struct{}](s S, comparator util.Comparator[V], consumer util.Consumer[V]) {
+	for _, v := range SortedValues(s, comparator) {
+		consumer(v)
+	}
+}
+
+func SortedValuesOrdered[V util.Ordered, S ~map[V]struct{}](s S) []V {
+	return SortedValues(s, util.Compare[V])
+}
+
+func SortedEachOrdered[V util.Ordered, S ~map[V]struct{}](s S, consumer util.Consumer[V]) {
+	SortedEach(s, util.Compare[V], consumer)
+}
+
+// EachOrdered visits the values of the set in the given order, skipping the values not in the set
+//
+// note: the values of the set missing from the order are not visited
+func EachOrdered[V comparable, S ~map[V]struct{}](s S, order []V, consumer util.Consumer[V]) {
+	for _, v := range order {
+		if _, found := s[v]; found {
+			consumer(v)
+		}
+	}
+}
+
+// internal
+
+func sortDeterministic[V any](values []V, comparator util.Comparator[V]) {
+	if comparator == nil {
+		panic("set: nil comparator")
+	}
+	comparator = util.ThenByHash(comparator)
+	sort.SliceStable(values, func(i, j int) bool { return comparator(values[i], values[j]) < 0 })
+}
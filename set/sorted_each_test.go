@@ -0,0 +1,109 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+	"github.com/gvaligiani/al.go/util"
+)
+
+func TestSortedValuesInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		items      set.Set[int64]
+		comparator util.Comparator[int64]
+		wantValues []int64
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			items:      nil,
+			comparator: util.Compare[int64],
+			wantValues: []int64{},
+		},
+		"empty": {
+			items:      EmptyInt64Set,
+			comparator: util.Compare[int64],
+			wantValues: []int64{},
+		},
+		"natural": {
+			items:      DefaultInt64Set,
+			comparator: util.Compare[int64],
+			wantValues: []int64{12, 21, 34, 52, 87},
+		},
+		"reverse": {
+			items:      DefaultInt64Set,
+			comparator: util.Reverse(util.Compare[int64]),
+			wantValues: []int64{87, 52, 34, 21, 12},
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotValues := testCase.items.SortedValues(testCase.comparator)
+
+		// assert
+		require.Equal(t, testCase.wantValues, gotValues, "wrong values!")
+	})
+}
+
+func TestSortedTies(t *testing.T) {
+
+	type Player struct {
+		Rank int
+		Name string
+	}
+	byRank := util.CompareBy(func(p Player) int { return p.Rank })
+
+	// ties are sorted in the same order on every run
+
+	players := set.New[Player]()
+	for i, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		players.Add(Player{Rank: i % 2, Name: name})
+	}
+	want := players.SortedValues(byRank)
+	for i := 0; i < 20; i++ {
+		require.Equal(t, want, players.Copy().SortedValues(byRank), "ties in another order!")
+	}
+
+	// a comparator is required
+
+	require.Panics(t, func() { players.SortedValues(nil) }, "nil comparator")
+}
+
+func TestSortedEach(t *testing.T) {
+
+	// items sorted by value
+
+	var items []Item
+	DefaultItemSet.SortedEach(util.CompareBy(func(i Item) int64 { return i.Value }), func(i Item) { items = append(items, i) })
+	require.Equal(t, []Item{{Value: 12}, {Value: 21}, {Value: 34}, {Value: 52}, {Value: 87}}, items, "wrong items order!")
+
+	// natural order
+
+	require.Equal(t, []int64{12, 21, 34, 52, 87}, set.SortedValuesOrdered(DefaultInt64Set), "ordered values")
+
+	type Name string
+	var names []Name
+	set.SortedEachOrdered(set.New[Name]("b", "c", "a"), func(n Name) { names = append(names, n) })
+	require.Equal(t, []Name{"a", "b", "c"}, names, "wrong named values order!")
+
+	// given order
+
+	var values []int64
+	DefaultInt64Set.EachOrdered([]int64{87, 1, 12}, func(v int64) { values = append(values, v) })
+	require.Equal(t, []int64{87, 12}, values, "wrong values order!")
+}
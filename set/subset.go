@@ -0,0 +1,25 @@
+package set
+
+func IsSubsetOf[V comparable, S ~map[V]struct{}](s S, other S) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v := range s {
+		if _, found := other[v]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+func IsProperSubsetOf[V comparable, S ~map[V]struct{}](s S, other S) bool {
+	return len(s) < len(other) && IsSubsetOf(s, other)
+}
+
+func IsSupersetOf[V comparable, S ~map[V]struct{}](s S, other S) bool {
+	return IsSubsetOf(other, s)
+}
+
+func IsProperSupersetOf[V comparable, S ~map[V]struct{}](s S, other S) bool {
+	return IsProperSubsetOf(other, s)
+}
@@ -0,0 +1,91 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestIsSubsetOfInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		left               set.Set[int64]
+		right              set.Set[int64]
+		wantSubset         bool
+		wantProperSubset   bool
+		wantSuperset       bool
+		wantProperSuperset bool
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			left:               nil,
+			right:              nil,
+			wantSubset:         true,
+			wantProperSubset:   false,
+			wantSuperset:       true,
+			wantProperSuperset: false,
+		},
+		"empty-of-default": {
+			left:               EmptyInt64Set,
+			right:              DefaultInt64Set,
+			wantSubset:         true,
+			wantProperSubset:   true,
+			wantSuperset:       false,
+			wantProperSuperset: false,
+		},
+		"self": {
+			left:               DefaultInt64Set,
+			right:              DefaultInt64Set,
+			wantSubset:         true,
+			wantProperSubset:   false,
+			wantSuperset:       true,
+			wantProperSuperset: false,
+		},
+		"part-of-default": {
+			left:               set.New[int64](12, 87),
+			right:              DefaultInt64Set,
+			wantSubset:         true,
+			wantProperSubset:   true,
+			wantSuperset:       false,
+			wantProperSuperset: false,
+		},
+		"default-of-part": {
+			left:               DefaultInt64Set,
+			right:              set.New[int64](12, 87),
+			wantSubset:         false,
+			wantProperSubset:   false,
+			wantSuperset:       true,
+			wantProperSuperset: true,
+		},
+		"other": {
+			left:               DefaultInt64Set,
+			right:              OtherInt64Set,
+			wantSubset:         false,
+			wantProperSubset:   false,
+			wantSuperset:       false,
+			wantProperSuperset: false,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute & assert
+		require.Equal(t, testCase.wantSubset, set.IsSubsetOf(testCase.left, testCase.right), "wrong subset!")
+		require.Equal(t, testCase.wantProperSubset, set.IsProperSubsetOf(testCase.left, testCase.right), "wrong proper subset!")
+		require.Equal(t, testCase.wantSuperset, set.IsSupersetOf(testCase.left, testCase.right), "wrong superset!")
+		require.Equal(t, testCase.wantProperSuperset, set.IsProperSupersetOf(testCase.left, testCase.right), "wrong proper superset!")
+	})
+}
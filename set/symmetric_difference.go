@@ -0,0 +1,36 @@
+package set
+
+func SymmetricDifference[V comparable, S ~map[V]struct{}](left S, right S) S {
+	if left == nil && right == nil {
+		return nil
+	}
+	difference := make(S, len(left)+len(right))
+	for v := range left {
+		if _, found := right[v]; !found {
+			difference[v] = struct{}{}
+		}
+	}
+	for v := range right {
+		if _, found := left[v]; !found {
+			difference[v] = struct{}{}
+		}
+	}
+	return difference
+}
+
+func SymmetricDifferenceWith[V comparable, S ~map[V]struct{}](s *S, other S) bool {
+	if s == nil || len(other) == 0 {
+		return false
+	}
+	if *s == nil {
+		*s = make(S, len(other))
+	}
+	for v := range other {
+		if _, found := (*s)[v]; found {
+			delete(*s, v)
+		} else {
+			(*s)[v] = struct{}{}
+		}
+	}
+	return true
+}
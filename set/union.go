@@ -0,0 +1,29 @@
+package set
+
+func Union[V comparable, S ~map[V]struct{}](left S, right S) S {
+	if left == nil && right == nil {
+		return nil
+	}
+	union := make(S, len(left)+len(right))
+	for v := range left {
+		union[v] = struct{}{}
+	}
+	for v := range right {
+		union[v] = struct{}{}
+	}
+	return union
+}
+
+func UnionWith[V comparable, S ~map[V]struct{}](s *S, other S) bool {
+	if s == nil || len(other) == 0 {
+		return false
+	}
+	if *s == nil {
+		*s = make(S, len(other))
+	}
+	size := len(*s)
+	for v := range other {
+		(*s)[v] = struct{}{}
+	}
+	return len(*s) > size
+}
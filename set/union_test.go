@@ -0,0 +1,81 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gvaligiani/al.go/set"
+	"github.com/gvaligiani/al.go/test"
+)
+
+func TestUnionInt64(t *testing.T) {
+
+	//
+	// test cases
+	//
+
+	type TestCase struct {
+		left        set.Set[int64]
+		right       set.Set[int64]
+		wantItems   set.Set[int64]
+		wantUpdated bool
+	}
+
+	testCases := map[string]TestCase{
+		"nil": {
+			left:        nil,
+			right:       nil,
+			wantItems:   nil,
+			wantUpdated: false,
+		},
+		"empty": {
+			left:        EmptyInt64Set,
+			right:       EmptyInt64Set,
+			wantItems:   EmptyInt64Set,
+			wantUpdated: false,
+		},
+		"with-empty": {
+			left:        DefaultInt64Set,
+			right:       EmptyInt64Set,
+			wantItems:   DefaultInt64Set,
+			wantUpdated: false,
+		},
+		"with-self": {
+			left:        DefaultInt64Set,
+			right:       DefaultInt64Set,
+			wantItems:   DefaultInt64Set,
+			wantUpdated: false,
+		},
+		"with-other": {
+			left:        DefaultInt64Set,
+			right:       OtherInt64Set,
+			wantItems:   set.New[int64](21, 12, 34, 87, 52, 69),
+			wantUpdated: true,
+		},
+	}
+
+	//
+	// run
+	//
+
+	test.RunTestCases(t, testCases, func(t *testing.T, logger *zap.Logger, testCase TestCase) {
+
+		// execute
+		gotItems := set.Union(testCase.left, testCase.right)
+
+		// assert
+		assertEqual(t, testCase.wantItems, gotItems, "wrong items!")
+
+		// execute in place
+		gotInPlace := set.Copy(testCase.left)
+		gotUpdated := gotInPlace.UnionWith(testCase.right)
+
+		// assert
+		require.Equal(t, testCase.wantUpdated, gotUpdated, "wrong updated!")
+		if testCase.left != nil {
+			assertEqual(t, testCase.wantItems, gotInPlace, "wrong items in place!")
+		}
+	})
+}
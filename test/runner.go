@@ -19,7 +19,7 @@ func RunTestCases[TestCase any](t *testing.T,testCases map[string]TestCase,runTe
 			logger := NewLogger()
 			logger.Info(fmt.Sprintf(" ----- %s/%v ",reflect.TypeOf(testCase).PkgPath(),t.Name()))
 			start := time.Now()
-			defer logger.Info(fmt.Sprintf(" >>> test completed in %v ",time.Since(start)))
+			defer func() { logger.Info(fmt.Sprintf(" >>> test completed in %v ",time.Since(start))) }()
 
 			runTestCase(t,NewTestCaseLogger(testName),testCase)
 		})
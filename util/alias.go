@@ -13,3 +13,4 @@ type BiSupplier[U any, V any] func() (U, V)
 
 type Transformer[V any, O any] func(V) O
 type BiTransformer[U any, V any, O any] func(U, V) O
+type TriTransformer[T any, U any, V any, O any] func(T, U, V) O
@@ -0,0 +1,130 @@
+package util
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// Cloner is implemented by values able to deep copy themselves
+//
+// note: Clone must not call DeepClone on its own receiver, which would call Clone again
+type Cloner[V any] interface {
+	Clone() V
+}
+
+// DeepClone recursively copies any value, so that the copy shares no pointer, slice or map with the original
+//
+// note:
+//   - values implementing Cloner of their own type are copied by their Clone method, at any depth
+//   - pointers, slices and maps reachable several times are copied once, so cycles and sharing are kept in the copy
+//   - unexported struct fields are copied as is, without recursion, since reflection cannot set them
+//   - map keys, channels, functions and unsafe pointers are copied as is
+func DeepClone[V any](value V) V {
+	c := &deepCloner{
+		pointers: map[visit]reflect.Value{},
+		slices:   map[sliceVisit]reflect.Value{},
+	}
+	src := reflect.ValueOf(&value).Elem()
+	dst := reflect.New(src.Type())
+	dst.Elem().Set(c.clone(src))
+	return *dst.Interface().(*V)
+}
+
+type sliceVisit struct {
+	ptr unsafe.Pointer
+	len int
+	typ reflect.Type
+}
+
+type deepCloner struct {
+	pointers map[visit]reflect.Value
+	slices   map[sliceVisit]reflect.Value
+}
+
+func (c *deepCloner) clone(src reflect.Value) reflect.Value {
+	if cloned, ok := c.cloneWithMethod(src); ok {
+		return cloned
+	}
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return src
+		}
+		key := visit{ptr: src.UnsafePointer(), typ: src.Type()}
+		if cloned, found := c.pointers[key]; found {
+			return cloned
+		}
+		dst := reflect.New(src.Type().Elem())
+		c.pointers[key] = dst
+		dst.Elem().Set(c.clone(src.Elem()))
+		return dst
+	case reflect.Interface:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(c.clone(src.Elem()))
+		return dst
+	case reflect.Struct:
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				dst.Field(i).Set(c.clone(src.Field(i)))
+			}
+		}
+		return dst
+	case reflect.Array:
+		dst := reflect.New(src.Type()).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(c.clone(src.Index(i)))
+		}
+		return dst
+	case reflect.Slice:
+		if src.IsNil() {
+			return src
+		}
+		key := sliceVisit{ptr: src.UnsafePointer(), len: src.Len(), typ: src.Type()}
+		if cloned, found := c.slices[key]; found {
+			return cloned
+		}
+		dst := reflect.MakeSlice(src.Type(), src.Len(), src.Cap())
+		c.slices[key] = dst
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(c.clone(src.Index(i)))
+		}
+		return dst
+	case reflect.Map:
+		if src.IsNil() {
+			return src
+		}
+		key := visit{ptr: src.UnsafePointer(), typ: src.Type()}
+		if cloned, found := c.pointers[key]; found {
+			return cloned
+		}
+		dst := reflect.MakeMapWithSize(src.Type(), src.Len())
+		c.pointers[key] = dst
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), c.clone(iter.Value()))
+		}
+		return dst
+	}
+	return src
+}
+
+// cloneWithMethod calls the Clone method of the value, if it returns the type of the value
+func (c *deepCloner) cloneWithMethod(src reflect.Value) (reflect.Value, bool) {
+	if !src.CanInterface() || (src.Kind() == reflect.Pointer || src.Kind() == reflect.Interface) && src.IsNil() {
+		return reflect.Value{}, false
+	}
+	method := src.MethodByName("Clone")
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 || methodType.Out(0) != src.Type() {
+		return reflect.Value{}, false
+	}
+	return method.Call(nil)[0], true
+}
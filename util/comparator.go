@@ -0,0 +1,75 @@
+package util
+
+// alias
+
+type Comparator[V any] func(V, V) int
+
+// ordered
+
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+func Compare[V Ordered](left V, right V) int {
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparator <-> reverse comparator
+
+func Reverse[V any](comparator Comparator[V]) Comparator[V] {
+	return func(left V, right V) int {
+		return comparator(right, left)
+	}
+}
+
+// ThenByHash breaks the ties of the comparator by the deep hash of the values,
+// so that sorting values coming from a map gives the same order on every run
+//
+// note: values which are deeply equal, or collide, keep their relative order with a stable sort
+func ThenByHash[V any](comparator Comparator[V]) Comparator[V] {
+	return func(left V, right V) int {
+		if c := comparator(left, right); c != 0 {
+			return c
+		}
+		return Compare(DeepHash(left), DeepHash(right))
+	}
+}
+
+// key comparator
+
+func CompareBy[V any, K Ordered](key Transformer[V, K]) Comparator[V] {
+	return func(left V, right V) int {
+		return Compare(key(left), key(right))
+	}
+}
+
+// comparator <-> less predicate
+
+func Less[V any](comparator Comparator[V]) BiPredicate[V, V] {
+	return func(left V, right V) bool {
+		return comparator(left, right) < 0
+	}
+}
+
+func CompareWithLess[V any](less BiPredicate[V, V]) Comparator[V] {
+	return func(left V, right V) int {
+		switch {
+		case less(left, right):
+			return -1
+		case less(right, left):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
@@ -32,3 +32,8 @@ func ConsumeOnSecondArg[U any, V any](consumer Consumer[V]) BiConsumer[U, V] {
 		consumer(v)
 	}
 }
+
+// error consumer
+
+type ErrConsumer[V any] func(V) error
+type BiErrConsumer[U any, V any] func(U, V) error
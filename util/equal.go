@@ -2,10 +2,25 @@ package util
 
 import "reflect"
 
+// Equaler is implemented by values able to compare themselves to another value faster than reflection
+//
+// note:
+//   - Equal must be an equivalence relation, like reflect.DeepEqual
+//   - when V is a pointer type, Equal is also called on nil receivers
+type Equaler[V any] interface {
+	Equal(other V) bool
+}
+
 func Equal[V comparable](left V, right V) bool {
 	return left == right
 }
 
+// DeepEqual uses the Equal method of the left value if it implements Equaler, and reflect.DeepEqual otherwise
 func DeepEqual[V any](left V, right V) bool {
-	return reflect.DeepEqual(left, right)
+	// note: left is boxed once, for both the assertion and the reflection
+	boxed := any(left)
+	if equaler, ok := boxed.(Equaler[V]); ok {
+		return equaler.Equal(right)
+	}
+	return reflect.DeepEqual(boxed, right)
 }
@@ -0,0 +1,205 @@
+package util
+
+import (
+	"hash/fnv"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// alias
+
+type HashFunc[V any] func(V) uint64
+
+// Hasher is implemented by values able to hash themselves faster than reflection
+//
+// note: the hash must be consistent with DeepEqual, so with Equal when the value is also an Equaler
+type Hasher interface {
+	Hash() uint64
+}
+
+// DeepHash hashes any value consistently with DeepEqual: deeply equal values have the same hash
+//
+// note:
+//   - values implementing Hasher are hashed by their Hash method
+//   - values implementing Equaler but not Hasher all share the same hash, since their equality is opaque:
+//     hash-based containers then fall back on a linear scan, so such types should also implement Hasher
+//   - unexported struct fields are read through reflection, without being interfaced
+//   - cycles through pointers, maps and slices are cut when a value revisits one of its ancestors,
+//     so deeply equal cycles of different lengths may not have the same hash
+func DeepHash[V any](value V) uint64 {
+	if hasher, ok := any(value).(Hasher); ok {
+		return hasher.Hash()
+	}
+	if _, ok := any(value).(Equaler[V]); ok {
+		return fnvOffset
+	}
+	h := &deepHasher{visited: map[visit]struct{}{}}
+	h.hash(reflect.ValueOf(&value).Elem())
+	return h.sum
+}
+
+// ComparableHash hashes any comparable value consistently with ==: equal values have the same hash
+//
+// note: unlike DeepHash, pointers and channels are hashed by address, not by content
+func ComparableHash[V comparable](value V) uint64 {
+	h := &deepHasher{identity: true}
+	h.hash(reflect.ValueOf(&value).Elem())
+	return h.sum
+}
+
+type visit struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+}
+
+type deepHasher struct {
+	sum      uint64
+	identity bool
+	visited  map[visit]struct{}
+}
+
+const (
+	fnvOffset uint64 = 14695981039346656037
+	fnvPrime  uint64 = 1099511628211
+)
+
+func (h *deepHasher) write(u uint64) {
+	if h.sum == 0 {
+		h.sum = fnvOffset
+	}
+	for i := 0; i < 8; i++ {
+		h.sum ^= u & 0xff
+		h.sum *= fnvPrime
+		u >>= 8
+	}
+}
+
+func (h *deepHasher) writeString(s string) {
+	f := fnv.New64a()
+	f.Write([]byte(s))
+	h.write(f.Sum64())
+}
+
+// enter marks the value as being visited, and returns false if it is already on the current path
+func (h *deepHasher) enter(v reflect.Value) (visit, bool) {
+	key := visit{ptr: unsafe.Pointer(v.Pointer()), typ: v.Type()}
+	if _, found := h.visited[key]; found {
+		return key, false
+	}
+	h.visited[key] = struct{}{}
+	return key, true
+}
+
+func (h *deepHasher) leave(key visit) {
+	delete(h.visited, key)
+}
+
+func (h *deepHasher) hash(v reflect.Value) {
+	if !v.IsValid() {
+		h.write(0)
+		return
+	}
+	h.write(uint64(v.Kind()))
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.write(1)
+		} else {
+			h.write(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.write(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		h.write(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		h.writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		h.writeFloat(real(c))
+		h.writeFloat(imag(c))
+	case reflect.String:
+		h.writeString(v.String())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			h.hash(v.Index(i))
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			h.write(0)
+			return
+		}
+		h.write(uint64(v.Len()))
+		key, ok := h.enter(v)
+		if !ok {
+			return
+		}
+		defer h.leave(key)
+		for i := 0; i < v.Len(); i++ {
+			h.hash(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			h.write(0)
+			return
+		}
+		h.write(uint64(v.Len()))
+		key, ok := h.enter(v)
+		if !ok {
+			return
+		}
+		defer h.leave(key)
+		// combine the entries without depending on the map iteration order
+		var sum uint64
+		iter := v.MapRange()
+		for iter.Next() {
+			entry := &deepHasher{visited: h.visited}
+			entry.hash(iter.Key())
+			entry.hash(iter.Value())
+			sum += entry.sum
+		}
+		h.write(sum)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			h.hash(v.Field(i))
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			h.write(0)
+			return
+		}
+		if h.identity {
+			h.write(uint64(v.Pointer()))
+			return
+		}
+		key, ok := h.enter(v)
+		if !ok {
+			return
+		}
+		defer h.leave(key)
+		h.hash(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			h.write(0)
+			return
+		}
+		h.writeString(v.Elem().Type().String())
+		h.hash(v.Elem())
+	case reflect.Func:
+		// functions are only deeply equal when both are nil
+		if v.IsNil() {
+			h.write(0)
+		}
+	default:
+		// chan, unsafe pointer: compared by identity
+		h.write(uint64(v.Pointer()))
+	}
+}
+
+func (h *deepHasher) writeFloat(f float64) {
+	if f == 0 {
+		// +0 and -0 are equal
+		f = 0
+	}
+	h.write(math.Float64bits(f))
+}
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// PanicError is the value re-panicked in the caller goroutine when a parallel worker panics
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in parallel worker: %v\n%s", e.Value, e.Stack)
+}
+
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// ParallelFor calls the consumer on each index in [0,size) using a bounded pool of workers
+//
+// note:
+//   - workers <= 0 means runtime.GOMAXPROCS(0) workers
+//   - on cancellation, the remaining indexes are skipped and the context error is returned
+//   - a panic in a worker stops the other workers, then is re-panicked as a *PanicError in the caller goroutine
+func ParallelFor(ctx context.Context, size int, workers int, consumer Consumer[int]) error {
+	if size == 0 {
+		return ctx.Err()
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > size {
+		workers = size
+	}
+
+	var next int64
+	var stopped int32
+	var once sync.Once
+	var panicked *PanicError
+	var wg sync.WaitGroup
+
+	worker := func() {
+		defer wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				once.Do(func() { panicked = &PanicError{Value: r, Stack: debug.Stack()} })
+				atomic.StoreInt32(&stopped, 1)
+			}
+		}()
+		for atomic.LoadInt32(&stopped) == 0 {
+			index := int(atomic.AddInt64(&next, 1) - 1)
+			if index >= size {
+				return
+			}
+			if ctx.Err() != nil {
+				atomic.StoreInt32(&stopped, 1)
+				return
+			}
+			consumer(index)
+		}
+	}
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go worker()
+	}
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+	if atomic.LoadInt32(&stopped) != 0 {
+		return ctx.Err()
+	}
+	return nil
+}